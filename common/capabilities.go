@@ -0,0 +1,145 @@
+package common
+
+import (
+	"sort"
+)
+
+// Operation is an action a connector can perform on an object.
+type Operation string
+
+const (
+	OperationRead   Operation = "read"
+	OperationCreate Operation = "create"
+	OperationUpdate Operation = "update"
+	OperationDelete Operation = "delete"
+)
+
+// PaginationStyle describes how Read moves between pages.
+type PaginationStyle string
+
+const (
+	// PaginationNone means all records are returned in a single response.
+	PaginationNone PaginationStyle = "none"
+	// PaginationCursor means an opaque token from the response is passed back to the provider.
+	PaginationCursor PaginationStyle = "cursor"
+	// PaginationOffset means records are skipped by count, ex: offset/limit or startAt.
+	PaginationOffset PaginationStyle = "offset"
+	// PaginationPage means pages are requested by their number.
+	PaginationPage PaginationStyle = "page"
+	// PaginationNextURL means the response includes the full URL of the next page.
+	PaginationNextURL PaginationStyle = "nextURL"
+)
+
+// ReadCapabilities describes which ReadParams are honoured when reading an object.
+type ReadCapabilities struct {
+	// Incremental is true when ReadParams.Since scopes records by modification time.
+	// When false, every Read is a full read.
+	Incremental bool
+	// Deleted is true when ReadParams.Deleted returns deleted records.
+	Deleted bool
+	// Filter is true when ReadParams.Filter is passed to the provider.
+	Filter bool
+	// Pagination is the style of paging.
+	Pagination PaginationStyle
+	// MaxPageSize is the number of records per page. Zero means it is decided by the provider.
+	MaxPageSize int
+}
+
+// ObjectCapabilities lists operations supported by the object.
+type ObjectCapabilities struct {
+	Read   bool
+	Create bool
+	Update bool
+	Delete bool
+	// ReadCapabilities is relevant only when Read is true.
+	ReadCapabilities ReadCapabilities
+}
+
+// Supports reports whether the operation is allowed on this object.
+func (o ObjectCapabilities) Supports(operation Operation) bool {
+	switch operation {
+	case OperationRead:
+		return o.Read
+	case OperationCreate:
+		return o.Create
+	case OperationUpdate:
+		return o.Update
+	case OperationDelete:
+		return o.Delete
+	default:
+		return false
+	}
+}
+
+// Capabilities is a matrix of object names, operations and read features.
+type Capabilities struct {
+	Objects map[string]ObjectCapabilities
+}
+
+// NewCapabilities creates an empty matrix. Use With* methods to populate it.
+func NewCapabilities() *Capabilities {
+	return &Capabilities{
+		Objects: make(map[string]ObjectCapabilities),
+	}
+}
+
+// WithRead marks objects as readable with given features.
+func (c *Capabilities) WithRead(features ReadCapabilities, objectNames ...string) *Capabilities {
+	for _, objectName := range objectNames {
+		object := c.Objects[objectName]
+		object.Read = true
+		object.ReadCapabilities = features
+		c.Objects[objectName] = object
+	}
+
+	return c
+}
+
+// WithOperation marks objects as supporting create, update or delete.
+// Use WithRead for read operation, which requires features.
+func (c *Capabilities) WithOperation(operation Operation, objectNames ...string) *Capabilities {
+	for _, objectName := range objectNames {
+		object := c.Objects[objectName]
+
+		switch operation {
+		case OperationRead:
+			object.Read = true
+		case OperationCreate:
+			object.Create = true
+		case OperationUpdate:
+			object.Update = true
+		case OperationDelete:
+			object.Delete = true
+		}
+
+		c.Objects[objectName] = object
+	}
+
+	return c
+}
+
+// Supports reports whether the operation is allowed on the object.
+// Unknown objects support nothing.
+func (c *Capabilities) Supports(objectName string, operation Operation) bool {
+	object, ok := c.Objects[objectName]
+	if !ok {
+		return false
+	}
+
+	return object.Supports(operation)
+}
+
+// ObjectNames returns sorted names of objects supporting the operation.
+func (c *Capabilities) ObjectNames(operation Operation) []string {
+	names := make([]string, 0, len(c.Objects))
+
+	for name, object := range c.Objects {
+		if object.Supports(operation) {
+			names = append(names, name)
+		}
+	}
+
+	sort.Strings(names)
+
+	return names
+}
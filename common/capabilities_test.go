@@ -0,0 +1,42 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	capabilities := NewCapabilities().
+		WithRead(ReadCapabilities{Incremental: true, Pagination: PaginationCursor}, "contacts", "accounts").
+		WithOperation(OperationCreate, "contacts", "notes").
+		WithOperation(OperationDelete, "notes")
+
+	tests := []struct {
+		name     string
+		input    Operation
+		expected []string
+	}{
+		{name: "Readable objects", input: OperationRead, expected: []string{"accounts", "contacts"}},
+		{name: "Objects that can be created", input: OperationCreate, expected: []string{"contacts", "notes"}},
+		{name: "Objects that can be updated", input: OperationUpdate, expected: []string{}},
+		{name: "Objects that can be deleted", input: OperationDelete, expected: []string{"notes"}},
+	}
+
+	for _, tt := range tests {
+		output := capabilities.ObjectNames(tt.input)
+		if !reflect.DeepEqual(output, tt.expected) {
+			t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, output)
+		}
+	}
+
+	// Operations added later must not reset read features.
+	if !capabilities.Objects["contacts"].ReadCapabilities.Incremental {
+		t.Fatalf("contacts lost read features")
+	}
+
+	if capabilities.Objects["notes"].Read {
+		t.Fatalf("notes must not be readable")
+	}
+}
@@ -0,0 +1,63 @@
+package jsonquery
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spyzhov/ajson"
+)
+
+// ErrUnsupportedPath is returned when path doesn't point to a single value, ex: wildcards or filters.
+var ErrUnsupportedPath = errors.New("path must select a single value")
+
+const (
+	pathRoot      = "$"
+	pathSeparator = "."
+)
+
+// IsNestedPath returns true if the field is written as dotted path or JSONPath.
+//
+//	->	Dotted path:			"attributes.email"
+//	->	JSONPath:				"$.via.channel", "$['Owner']['Name']", "$.emails[0].value"
+func IsNestedPath(field string) bool {
+	return strings.HasPrefix(field, pathRoot) || strings.Contains(field, pathSeparator)
+}
+
+// ParsePath splits nested path into keys, which form a zoom path.
+// Array elements are referenced by index, only paths selecting a single value are supported.
+func ParsePath(path string) ([]string, error) {
+	if !strings.HasPrefix(path, pathRoot) {
+		return strings.Split(path, pathSeparator), nil
+	}
+
+	tokens, err := ajson.ParseJSONPath(path)
+	if err != nil {
+		return nil, errors.Join(ErrUnsupportedPath, err)
+	}
+
+	keys := make([]string, 0, len(tokens))
+
+	// The first token is the root.
+	for _, token := range tokens[1:] {
+		if token == ".." || token == "*" || strings.ContainsAny(token, "?(),:") {
+			return nil, fmt.Errorf("%w: %v", ErrUnsupportedPath, path)
+		}
+
+		// Bracket notation keeps quotes around the key.
+		keys = append(keys, strings.Trim(token, `'"`))
+	}
+
+	return keys, nil
+}
+
+// DottedPath converts nested path into dotted notation, ex: "$['Owner']['Name']" becomes "Owner.Name".
+// This format is used by query languages selecting fields of related objects.
+func DottedPath(path string) (string, error) {
+	keys, err := ParsePath(path)
+	if err != nil {
+		return "", err
+	}
+
+	return strings.Join(keys, pathSeparator), nil
+}
@@ -2,6 +2,7 @@ package common
 
 import (
 	"context"
+	"errors"
 	"net/http"
 	"sync"
 
@@ -25,6 +26,10 @@ type TokenSourceWithContext interface {
 
 type OAuthOption func(*oauthClientParams)
 
+// ErrNotOAuthClient is returned when an access token is requested from a client
+// which wasn't created by NewOAuthHTTPClient.
+var ErrNotOAuthClient = errors.New("http client is not an OAuth client")
+
 // NewOAuthHTTPClient returns a new http client, with automatic OAuth authentication. Specifically
 // this means that the client will automatically refresh the access token whenever it expires.
 func NewOAuthHTTPClient(ctx context.Context, opts ...OAuthOption) (AuthenticatedHTTPClient, error) { //nolint:ireturn
@@ -158,6 +163,24 @@ func newOAuthClient(ctx context.Context, params *oauthClientParams) Authenticate
 	}
 }
 
+// OAuthTokenFromClient returns the current access token used by a client created with NewOAuthHTTPClient.
+// The token is refreshed if it has expired, exactly as it would be before sending a request.
+// This is useful for APIs which expect the token inside the request payload rather than in the headers,
+// for example SOAP APIs that require a session header.
+func OAuthTokenFromClient(ctx context.Context, client AuthenticatedHTTPClient) (*oauth2.Token, error) {
+	httpClient, ok := client.(*http.Client)
+	if !ok {
+		return nil, ErrNotOAuthClient
+	}
+
+	transport, ok := httpClient.Transport.(*oauth2Transport)
+	if !ok {
+		return nil, ErrNotOAuthClient
+	}
+
+	return transport.token(ctx)
+}
+
 type oauth2Transport struct {
 	Source       oauth2.TokenSource
 	Base         http.RoundTripper
@@ -176,18 +199,7 @@ func (t *oauth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 		}()
 	}
 
-	var (
-		token *oauth2.Token
-		err   error
-	)
-
-	srcCtx, ok := t.Source.(TokenSourceWithContext)
-	if ok {
-		token, err = srcCtx.TokenWithContext(req.Context())
-	} else {
-		token, err = t.Source.Token()
-	}
-
+	token, err := t.token(req.Context())
 	if err != nil {
 		return nil, err
 	}
@@ -219,6 +231,15 @@ func (t *oauth2Transport) RoundTrip(req *http.Request) (*http.Response, error) {
 	return rsp, nil
 }
 
+func (t *oauth2Transport) token(ctx context.Context) (*oauth2.Token, error) {
+	srcCtx, ok := t.Source.(TokenSourceWithContext)
+	if ok {
+		return srcCtx.TokenWithContext(ctx)
+	}
+
+	return t.Source.Token()
+}
+
 func (t *oauth2Transport) base() http.RoundTripper {
 	if t.Base != nil {
 		return t.Base
@@ -0,0 +1,28 @@
+package paramsbuilder
+
+import (
+	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
+)
+
+// Region params sets up datacenter or environment of the provider, ex: "eu", "sandbox".
+// Region is optional, the provider default is used when it is empty.
+type Region struct {
+	Name string
+}
+
+func (p *Region) ValidateParams() error {
+	return nil
+}
+
+func (p *Region) WithRegion(region string) {
+	p.Name = region
+}
+
+// GetSubstitutionPlan of the region describes how to insert its value into string templates.
+// This makes Region parameter a catalog variable.
+func (p *Region) GetSubstitutionPlan() catalogreplacer.SubstitutionPlan {
+	return catalogreplacer.SubstitutionPlan{
+		From: catalogreplacer.VariableRegion,
+		To:   p.Name,
+	}
+}
@@ -1,6 +1,8 @@
 package common
 
 import (
+	"slices"
+	"strconv"
 	"strings"
 
 	"github.com/amp-labs/connectors/common/jsonquery"
@@ -19,13 +21,20 @@ type (
 // The nextPageFunc returns the URL for the next page of results.
 // The marshalFunc is used to structure the data into an array of ReadResultRows.
 // The fields are used to populate ReadResultRow.Fields.
+// The options, ex: WithPreservedFieldCase, customize keys of ReadResultRow.Fields.
 func ParseResult(
 	resp *JSONHTTPResponse,
 	recordsFunc func(*ajson.Node) ([]map[string]any, error),
 	nextPageFunc func(*ajson.Node) (string, error),
 	marshalFunc func([]map[string]any, []string) ([]ReadResultRow, error),
 	fields datautils.Set[string],
+	options ...ParseOption,
 ) (*ReadResult, error) {
+	settings := parseSettings{}
+	for _, option := range options {
+		option(&settings)
+	}
+
 	body, ok := resp.Body()
 	if !ok {
 		return nil, ErrEmptyJSONHTTPResponse
@@ -46,11 +55,18 @@ func ParseResult(
 		return nil, err
 	}
 
+	if settings.preserveFieldCase {
+		if err = ApplyPreservedFieldCase(records, marshaledData, marshalFunc, fields.List()); err != nil {
+			return nil, err
+		}
+	}
+
 	// Next page doesn't exist if:
 	// * either there is no next page token,
 	// * or current page was empty.
 	// This will guarantee that Read is finite.
-	done := nextPage == "" || len(marshaledData) == 0
+	// Pages filtered on the client side may be empty, while provider still has records to paginate.
+	done := nextPage == "" || (len(marshaledData) == 0 && !settings.clientSideFilter)
 
 	return &ReadResult{
 		Rows:     int64(len(marshaledData)),
@@ -60,8 +76,94 @@ func ParseResult(
 	}, nil
 }
 
+// ParseOption customizes how ParseResult builds ReadResultRow.
+type ParseOption func(*parseSettings)
+
+type parseSettings struct {
+	preserveFieldCase bool
+	clientSideFilter  bool
+}
+
+// WithPreservedFieldCase keys ReadResultRow.Fields exactly as fields were requested, when enabled.
+// Usually it is set from ReadParams.PreserveFieldCase.
+func WithPreservedFieldCase(enabled bool) ParseOption {
+	return func(settings *parseSettings) {
+		settings.preserveFieldCase = enabled
+	}
+}
+
+// WithClientSideFilter must be used when recordsFunc drops records of the provider response,
+// ex: records older than ReadParams.Since. Empty page then doesn't end the Read,
+// only the absence of the next page token does.
+func WithClientSideFilter() ParseOption {
+	return func(settings *parseSettings) {
+		settings.clientSideFilter = true
+	}
+}
+
+// ApplyPreservedFieldCase replaces lowercase keys produced by marshal function with requested field names.
+// Fields differing only by case, ex: "Foo__c" and "foo__c", share lowercase key,
+// therefore each of them is marshaled separately.
+// ParseResult applies it given WithPreservedFieldCase, reads which don't use ParseResult may call it directly.
+func ApplyPreservedFieldCase(
+	records []map[string]any, rows []ReadResultRow,
+	marshalFunc func([]map[string]any, []string) ([]ReadResultRow, error),
+	fields []string,
+) error {
+	groups := make(map[string][]string)
+	for _, field := range fields {
+		key := strings.ToLower(field)
+		groups[key] = append(groups[key], field)
+	}
+
+	for key, group := range groups {
+		if len(group) == 1 {
+			renameFieldKey(rows, key, group[0])
+
+			continue
+		}
+
+		for _, field := range group {
+			single, err := marshalFunc(records, []string{field})
+			if err != nil {
+				return err
+			}
+
+			for index := range rows {
+				if value, ok := single[index].Fields[key]; ok {
+					rows[index].Fields[field] = value
+				}
+			}
+		}
+
+		// Lowercase key is kept only when it was one of the requested names.
+		if !slices.Contains(group, key) {
+			for index := range rows {
+				delete(rows[index].Fields, key)
+			}
+		}
+	}
+
+	return nil
+}
+
+func renameFieldKey(rows []ReadResultRow, from, to string) {
+	if from == to {
+		return
+	}
+
+	for index := range rows {
+		if value, ok := rows[index].Fields[from]; ok {
+			delete(rows[index].Fields, from)
+			rows[index].Fields[to] = value
+		}
+	}
+}
+
 // ExtractLowercaseFieldsFromRaw returns a map of fields from a record.
 // The fields are all returned in lowercase.
+// Nested values are selected by dotted path or JSONPath, ex: "owner.name" or "$.via.channel",
+// the requested path becomes the key.
 func ExtractLowercaseFieldsFromRaw(fields []string, record map[string]interface{}) map[string]interface{} {
 	out := make(map[string]interface{}, len(fields))
 
@@ -75,14 +177,80 @@ func ExtractLowercaseFieldsFromRaw(fields []string, record map[string]interface{
 		// Lowercase the field name to make lookup case-insensitive.
 		lowercaseField := strings.ToLower(field)
 
+		// Exact spelling takes precedence over keys differing by case.
+		if value, ok := record[field]; ok {
+			out[lowercaseField] = value
+
+			continue
+		}
+
+		// Top level key takes precedence, some providers use dots in field names.
 		if value, ok := lowercaseRecord[lowercaseField]; ok {
 			out[lowercaseField] = value
+
+			continue
+		}
+
+		if value, ok := lookupNestedField(record, field); ok {
+			out[lowercaseField] = value
 		}
 	}
 
 	return out
 }
 
+// lookupNestedField follows the path through nested objects and arrays.
+// Keys are matched case-insensitively.
+func lookupNestedField(record map[string]any, field string) (any, bool) {
+	if !jsonquery.IsNestedPath(field) {
+		return nil, false
+	}
+
+	keys, err := jsonquery.ParsePath(field)
+	if err != nil {
+		return nil, false
+	}
+
+	var current any = record
+
+	for _, key := range keys {
+		switch node := current.(type) {
+		case map[string]any:
+			value, ok := lookupKey(node, key)
+			if !ok {
+				return nil, false
+			}
+
+			current = value
+		case []any:
+			index, err := strconv.Atoi(key)
+			if err != nil || index < 0 || index >= len(node) {
+				return nil, false
+			}
+
+			current = node[index]
+		default:
+			return nil, false
+		}
+	}
+
+	return current, true
+}
+
+func lookupKey(object map[string]any, key string) (any, bool) {
+	if value, ok := object[key]; ok {
+		return value, true
+	}
+
+	for name, value := range object {
+		if strings.EqualFold(name, key) {
+			return value, true
+		}
+	}
+
+	return nil, false
+}
+
 func GetMarshaledData(records []map[string]any, fields []string) ([]ReadResultRow, error) {
 	data := make([]ReadResultRow, len(records))
 
@@ -0,0 +1,120 @@
+package common
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+func TestExtractLowercaseFieldsFromRaw(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	record := map[string]any{
+		"Id":    "500ak000001",
+		"hs.id": "dotted key",
+		"Owner": map[string]any{
+			"attributes": map[string]any{"type": "User"},
+			"Name":       "Ada Lovelace",
+		},
+		"via": map[string]any{
+			"channel": "email",
+			"source":  map[string]any{"from": map[string]any{"address": "ada@example.com"}},
+		},
+		"emails": []any{
+			map[string]any{"value": "first@example.com"},
+			map[string]any{"value": "second@example.com"},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		fields   []string
+		expected map[string]any
+	}{
+		{
+			name:     "Top level keys are case-insensitive",
+			fields:   []string{"id"},
+			expected: map[string]any{"id": "500ak000001"},
+		},
+		{
+			name:     "Top level key with dot takes precedence over path",
+			fields:   []string{"hs.id"},
+			expected: map[string]any{"hs.id": "dotted key"},
+		},
+		{
+			name:   "Dotted path selects nested value",
+			fields: []string{"Owner.Name", "via.source.from.address"},
+			expected: map[string]any{
+				"owner.name":              "Ada Lovelace",
+				"via.source.from.address": "ada@example.com",
+			},
+		},
+		{
+			name:   "JSONPath selects nested value and array element",
+			fields: []string{"$.via.channel", "$['Owner']['Name']", "$.emails[1].value"},
+			expected: map[string]any{
+				"$.via.channel":      "email",
+				"$['owner']['name']": "Ada Lovelace",
+				"$.emails[1].value":  "second@example.com",
+			},
+		},
+		{
+			name:     "Missing and ambiguous paths are omitted",
+			fields:   []string{"via.unknown", "emails[5].value", "$.emails[*].value", "$..value"},
+			expected: map[string]any{},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := ExtractLowercaseFieldsFromRaw(tt.fields, record)
+			if !reflect.DeepEqual(output, tt.expected) {
+				t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestApplyPreservedFieldCase(t *testing.T) {
+	t.Parallel()
+
+	fields := []string{"Name", "Foo__c", "foo__c", "Owner.Name"}
+	records := []map[string]any{{
+		"Name":   "Acme",
+		"Foo__c": "custom field",
+		"foo__c": "managed package field",
+		"Owner":  map[string]any{"Name": "Ada Lovelace"},
+	}}
+
+	rows, err := GetMarshaledData(records, fields)
+	if err != nil {
+		t.Fatalf("failed to marshal records: %v", err)
+	}
+
+	if err = ApplyPreservedFieldCase(records, rows, GetMarshaledData, fields); err != nil {
+		t.Fatalf("failed to preserve field case: %v", err)
+	}
+
+	expected := map[string]any{
+		"Name":       "Acme",
+		"Foo__c":     "custom field",
+		"foo__c":     "managed package field",
+		"Owner.Name": "Ada Lovelace",
+	}
+
+	if !reflect.DeepEqual(rows[0].Fields, expected) {
+		t.Fatalf("expected: (%v), got: (%v)", expected, rows[0].Fields)
+	}
+
+	keys := ReadParams{Fields: datautils.NewStringSet(fields...), PreserveFieldCase: true}.FieldKeys()
+	for _, field := range fields {
+		if _, ok := rows[0].Fields[keys[field]]; !ok {
+			t.Fatalf("field %v is not found under key %v", field, keys[field])
+		}
+	}
+}
@@ -0,0 +1,112 @@
+package common
+
+import (
+	"context"
+	"errors"
+
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// ErrRecordNotFound is returned when the record with requested ID doesn't exist.
+var ErrRecordNotFound = errors.New("record not found")
+
+// GetRecordParams defines how a single record is fetched by its ID.
+type GetRecordParams struct {
+	// The name of the object the record belongs to, e.g. "contacts"
+	ObjectName string // required
+
+	// The ID of the record, e.g. "003Dp000004vPUVIA2"
+	RecordId string // required
+
+	// The fields we are reading from the record, e.g. ["Id", "Email"]
+	Fields datautils.StringSet // required, at least one field needed
+
+	// PreserveFieldCase keys ReadResultRow.Fields exactly as fields were requested, see ReadParams.PreserveFieldCase.
+	PreserveFieldCase bool // optional, defaults to false
+}
+
+func (p GetRecordParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	if len(p.RecordId) == 0 {
+		return ErrMissingRecordID
+	}
+
+	if len(p.Fields) == 0 {
+		return ErrMissingFields
+	}
+
+	return nil
+}
+
+// GetRecordsParams defines how several records of the same object are fetched by their IDs.
+type GetRecordsParams struct {
+	// The name of the object records belong to, e.g. "contacts"
+	ObjectName string // required
+
+	// The IDs of the records. Records which don't exist are omitted from the result.
+	RecordIds []string // required, at least one ID needed
+
+	// The fields we are reading from the records, e.g. ["Id", "Email"]
+	Fields datautils.StringSet // required, at least one field needed
+
+	// PreserveFieldCase keys ReadResultRow.Fields exactly as fields were requested, see ReadParams.PreserveFieldCase.
+	PreserveFieldCase bool // optional, defaults to false
+}
+
+func (p GetRecordsParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	if len(p.RecordIds) == 0 {
+		return ErrMissingRecordID
+	}
+
+	if len(p.Fields) == 0 {
+		return ErrMissingFields
+	}
+
+	return nil
+}
+
+// Batches splits record IDs into groups no larger than the size accepted by a single provider request.
+func (p GetRecordsParams) Batches(size int) [][]string {
+	batches := make([][]string, 0, (len(p.RecordIds)+size-1)/size)
+
+	for start := 0; start < len(p.RecordIds); start += size {
+		end := min(start+size, len(p.RecordIds))
+		batches = append(batches, p.RecordIds[start:end])
+	}
+
+	return batches
+}
+
+// GetRecordsFunc fetches records by their IDs, see GetRecordViaBatch.
+type GetRecordsFunc func(ctx context.Context, params GetRecordsParams) ([]ReadResultRow, error)
+
+// GetRecordViaBatch fetches a single record using batch read of the connector.
+// ErrRecordNotFound is returned when the batch doesn't include the record.
+func GetRecordViaBatch(ctx context.Context, params GetRecordParams, getRecords GetRecordsFunc) (*ReadResultRow, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	rows, err := getRecords(ctx, GetRecordsParams{
+		ObjectName:        params.ObjectName,
+		RecordIds:         []string{params.RecordId},
+		Fields:            params.Fields,
+		PreserveFieldCase: params.PreserveFieldCase,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rows) == 0 {
+		return nil, ErrRecordNotFound
+	}
+
+	return &rows[0], nil
+}
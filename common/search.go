@@ -0,0 +1,284 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"regexp"
+	"strings"
+
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+var (
+	// ErrUnsupportedSearchOperator is returned when provider cannot express the comparison.
+	ErrUnsupportedSearchOperator = errors.New("search operator is not supported by the provider")
+	// ErrUnsupportedSearchFilter is returned when provider cannot express the shape of the filter, ex: nesting.
+	ErrUnsupportedSearchFilter = errors.New("search filter is not supported by the provider")
+	// ErrMissingSearchField is returned when condition doesn't name the field.
+	ErrMissingSearchField = errors.New("search condition must have a field")
+	// ErrEmptySearchCompound is returned when compound, ex: SearchAll(), has no nested filters.
+	ErrEmptySearchCompound = errors.New("search compound must have at least one filter")
+	// ErrInvalidSearchIdentifier is returned when object or field name is not a plain identifier.
+	ErrInvalidSearchIdentifier = errors.New("search object or field name is not a valid identifier")
+	// ErrUnsupportedSearchValue is returned when value type cannot be written as a literal of the query language.
+	ErrUnsupportedSearchValue = errors.New("search value type is not supported by the provider")
+)
+
+// searchIdentifierRegex matches names which are safe to interpolate into query languages, ex: "Owner.Name".
+var searchIdentifierRegex = regexp.MustCompile(`^[A-Za-z_][A-Za-z0-9_.]*$`) // nolint:gochecknoglobals
+
+// SearchOperator compares a field with the value.
+type SearchOperator string
+
+const (
+	SearchOperatorEquals             SearchOperator = "eq"
+	SearchOperatorNotEquals          SearchOperator = "ne"
+	SearchOperatorGreaterThan        SearchOperator = "gt"
+	SearchOperatorGreaterThanOrEqual SearchOperator = "gte"
+	SearchOperatorLessThan           SearchOperator = "lt"
+	SearchOperatorLessThanOrEqual    SearchOperator = "lte"
+	// SearchOperatorIn expects a slice as the value.
+	SearchOperatorIn SearchOperator = "in"
+	// SearchOperatorContains matches a substring, some providers match whole words only.
+	SearchOperatorContains SearchOperator = "contains"
+)
+
+// SearchLogic joins nested filters.
+type SearchLogic string
+
+const (
+	SearchLogicAnd SearchLogic = "and"
+	SearchLogicOr  SearchLogic = "or"
+)
+
+// SearchFilter is either a field condition or, when Logic is set, a compound of nested filters.
+// Use SearchCondition, SearchAll and SearchAny to build it.
+type SearchFilter struct {
+	Logic   SearchLogic
+	Filters []SearchFilter
+
+	Field    string
+	Operator SearchOperator
+	// Value is compared with the field, ex: string, number, boolean, time.Time or a slice for "in" operator.
+	Value any
+}
+
+// SearchCondition compares record field with the value.
+func SearchCondition(field string, operator SearchOperator, value any) SearchFilter {
+	return SearchFilter{
+		Field:    field,
+		Operator: operator,
+		Value:    value,
+	}
+}
+
+// SearchAll matches records satisfying every filter.
+func SearchAll(filters ...SearchFilter) SearchFilter {
+	return SearchFilter{
+		Logic:   SearchLogicAnd,
+		Filters: filters,
+	}
+}
+
+// SearchAny matches records satisfying any filter.
+func SearchAny(filters ...SearchFilter) SearchFilter {
+	return SearchFilter{
+		Logic:   SearchLogicOr,
+		Filters: filters,
+	}
+}
+
+// IsCompound returns true if the filter joins nested filters.
+func (f SearchFilter) IsCompound() bool {
+	return len(f.Logic) != 0
+}
+
+// IsEmpty returns true for zero filter, which matches every record.
+func (f SearchFilter) IsEmpty() bool {
+	return !f.IsCompound() && len(f.Field) == 0 && len(f.Operator) == 0
+}
+
+// Conditions returns field conditions of the filter, empty when filter nests compounds.
+// It is useful for providers only accepting a list of conditions joined by the same logic.
+func (f SearchFilter) Conditions() ([]SearchFilter, bool) {
+	if !f.IsCompound() {
+		return []SearchFilter{f}, true
+	}
+
+	for _, nested := range f.Filters {
+		if nested.IsCompound() {
+			return nil, false
+		}
+	}
+
+	return f.Filters, true
+}
+
+// Values returns elements of the value compared by "in" operator, non slice value is a single element list.
+func (f SearchFilter) Values() []any {
+	list := reflect.ValueOf(f.Value)
+	if list.Kind() != reflect.Slice {
+		return []any{f.Value}
+	}
+
+	values := make([]any, list.Len())
+	for index := range values {
+		values[index] = list.Index(index).Interface()
+	}
+
+	return values
+}
+
+func (f SearchFilter) validate() error {
+	if f.IsEmpty() {
+		return nil
+	}
+
+	if !f.IsCompound() {
+		if len(f.Field) == 0 {
+			return ErrMissingSearchField
+		}
+
+		return nil
+	}
+
+	if len(f.Filters) == 0 {
+		return ErrEmptySearchCompound
+	}
+
+	for _, nested := range f.Filters {
+		if nested.IsEmpty() {
+			return ErrMissingSearchField
+		}
+
+		if err := nested.validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SearchSort orders search results by the field.
+type SearchSort struct {
+	Field      string
+	Descending bool
+}
+
+// SearchParams defines provider-neutral search of records.
+type SearchParams struct {
+	// The name of the object we are searching, e.g. "contacts"
+	ObjectName string // required
+
+	// Filter selects records, zero value matches every record.
+	Filter SearchFilter // optional
+
+	// Sort orders the results, provider default is used when omitted.
+	Sort []SearchSort // optional
+
+	// The fields we are reading from the object, e.g. ["id", "email"]
+	Fields datautils.StringSet // required, at least one field needed
+
+	// PageSize overrides the number of records per page, provider default is used when omitted.
+	PageSize int // optional
+
+	// NextPage is an opaque token returned by the previous search with the same parameters.
+	NextPage NextPageToken // optional
+
+	// PreserveFieldCase keys ReadResultRow.Fields exactly as fields were requested, see ReadParams.PreserveFieldCase.
+	PreserveFieldCase bool // optional, defaults to false
+}
+
+func (p SearchParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return ErrMissingObjects
+	}
+
+	if len(p.Fields) == 0 {
+		return ErrMissingFields
+	}
+
+	return p.Filter.validate()
+}
+
+// ValidateIdentifiers checks that object name, selected fields, filter fields and sort fields are plain identifiers.
+// Connectors interpolating names into query languages, ex: SOQL or OData, call it in addition to ValidateParams,
+// so that names from user input cannot alter the query. Filter values are escaped by each connector.
+func (p SearchParams) ValidateIdentifiers() error {
+	names := []string{p.ObjectName}
+	names = append(names, p.Fields.List()...)
+	names = append(names, p.Filter.fields()...)
+
+	for _, sort := range p.Sort {
+		names = append(names, sort.Field)
+	}
+
+	for _, name := range names {
+		if !searchIdentifierRegex.MatchString(name) {
+			return fmt.Errorf("%w: %q", ErrInvalidSearchIdentifier, name)
+		}
+	}
+
+	return nil
+}
+
+// fields returns names of fields compared by the filter and its nested filters.
+func (f SearchFilter) fields() []string {
+	if f.IsEmpty() {
+		return nil
+	}
+
+	if !f.IsCompound() {
+		return []string{f.Field}
+	}
+
+	var fields []string
+	for _, nested := range f.Filters {
+		fields = append(fields, nested.fields()...)
+	}
+
+	return fields
+}
+
+// SearchExpression renders filter as an infix expression, used by query languages like SOQL or OData.
+type SearchExpression struct {
+	And string
+	Or  string
+	// Condition renders a single field comparison.
+	Condition func(condition SearchFilter) (string, error)
+}
+
+// Render returns the expression, nested compounds are wrapped in parentheses.
+func (e SearchExpression) Render(filter SearchFilter) (string, error) {
+	if !filter.IsCompound() {
+		return e.Condition(filter)
+	}
+
+	joiner := e.And
+	if filter.Logic == SearchLogicOr {
+		joiner = e.Or
+	}
+
+	parts := make([]string, 0, len(filter.Filters))
+
+	for _, nested := range filter.Filters {
+		part, err := e.Render(nested)
+		if err != nil {
+			return "", err
+		}
+
+		if nested.IsCompound() && len(nested.Filters) > 1 {
+			part = "(" + part + ")"
+		}
+
+		parts = append(parts, part)
+	}
+
+	return strings.Join(parts, " "+joiner+" "), nil
+}
+
+// UnsupportedSearchOperator reports operator which the provider cannot express.
+func UnsupportedSearchOperator(operator SearchOperator) error {
+	return fmt.Errorf("%w: %q", ErrUnsupportedSearchOperator, operator)
+}
@@ -0,0 +1,147 @@
+package common
+
+import (
+	"errors"
+	"fmt"
+	"testing"
+
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+func TestSearchExpression(t *testing.T) {
+	t.Parallel()
+
+	expression := SearchExpression{
+		And: "AND",
+		Or:  "OR",
+		Condition: func(condition SearchFilter) (string, error) {
+			if condition.Operator != SearchOperatorEquals {
+				return "", UnsupportedSearchOperator(condition.Operator)
+			}
+
+			return fmt.Sprintf("%v = %v", condition.Field, condition.Value), nil
+		},
+	}
+
+	tests := []struct {
+		name        string
+		input       SearchFilter
+		expected    string
+		expectedErr error
+	}{
+		{
+			name:     "Single condition",
+			input:    SearchCondition("Email", SearchOperatorEquals, "ada"),
+			expected: "Email = ada",
+		},
+		{
+			name: "Nested compounds are wrapped in parentheses",
+			input: SearchAll(
+				SearchCondition("Type", SearchOperatorEquals, "person"),
+				SearchAny(
+					SearchCondition("Email", SearchOperatorEquals, "ada"),
+					SearchCondition("Phone", SearchOperatorEquals, "42"),
+				),
+				SearchAny(SearchCondition("Name", SearchOperatorEquals, "Ada")),
+			),
+			expected: "Type = person AND (Email = ada OR Phone = 42) AND Name = Ada",
+		},
+		{
+			name:        "Condition error is propagated",
+			input:       SearchAny(SearchCondition("Age", SearchOperatorGreaterThan, 1)),
+			expectedErr: ErrUnsupportedSearchOperator,
+		},
+	}
+
+	for _, tt := range tests {
+		output, err := expression.Render(tt.input)
+		if !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("%s: expected error: (%v), got: (%v)", tt.name, tt.expectedErr, err)
+		}
+
+		if output != tt.expected {
+			t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, output)
+		}
+	}
+}
+
+func TestSearchParamsValidation(t *testing.T) {
+	t.Parallel()
+
+	params := SearchParams{
+		ObjectName: "contacts",
+		Fields:     datautils.NewStringSet("id"),
+		Filter:     SearchAll(SearchCondition("", SearchOperatorEquals, "ada")),
+	}
+
+	if err := params.ValidateParams(); !errors.Is(err, ErrMissingSearchField) {
+		t.Fatalf("expected missing field error, got: (%v)", err)
+	}
+
+	params.Filter = SearchAny(SearchCondition("email", SearchOperatorEquals, "ada"), SearchAll())
+	if err := params.ValidateParams(); !errors.Is(err, ErrEmptySearchCompound) {
+		t.Fatalf("expected empty compound error, got: (%v)", err)
+	}
+
+	params.Filter = SearchFilter{}
+	if err := params.ValidateParams(); err != nil {
+		t.Fatalf("empty filter must be valid, got: (%v)", err)
+	}
+}
+
+func TestSearchParamsValidateIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		input       SearchParams
+		expectedErr error
+	}{
+		{
+			name: "Field of related object",
+			input: SearchParams{
+				ObjectName: "Contact",
+				Filter:     SearchCondition("Account.Name", SearchOperatorEquals, "Acme"),
+				Sort:       []SearchSort{{Field: "CreatedDate"}},
+			},
+		},
+		{
+			name: "Nested field with injected condition",
+			input: SearchParams{
+				ObjectName: "Contact",
+				Filter: SearchAll(
+					SearchCondition("Email", SearchOperatorEquals, "ada"),
+					SearchCondition("Id != null OR Name", SearchOperatorEquals, "x"),
+				),
+			},
+			expectedErr: ErrInvalidSearchIdentifier,
+		},
+		{
+			name: "Selected field with subquery",
+			input: SearchParams{
+				ObjectName: "Contact",
+				Fields:     datautils.NewStringSet("Id", "(SELECT Id FROM Cases)"),
+			},
+			expectedErr: ErrInvalidSearchIdentifier,
+		},
+		{
+			name:        "Object name with clause",
+			input:       SearchParams{ObjectName: "Contact WHERE Id != null"},
+			expectedErr: ErrInvalidSearchIdentifier,
+		},
+		{
+			name: "Sort field with direction",
+			input: SearchParams{
+				ObjectName: "Contact",
+				Sort:       []SearchSort{{Field: "Name DESC"}},
+			},
+			expectedErr: ErrInvalidSearchIdentifier,
+		},
+	}
+
+	for _, tt := range tests {
+		if err := tt.input.ValidateIdentifiers(); !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("%s: expected error: (%v), got: (%v)", tt.name, tt.expectedErr, err)
+		}
+	}
+}
@@ -6,8 +6,21 @@ import (
 
 const (
 	VariableWorkspace = "workspace"
+	VariableServer    = "server"
+	// VariableRegion selects datacenter or environment of the provider, ex: EU datacenter or sandbox.
+	// Accepted values are declared per provider.
+	VariableRegion = "region"
 )
 
+// Variables returns names of all variables that may appear in the catalog as `{{.VAR_NAME}}`.
+func Variables() []string {
+	return []string{
+		VariableWorkspace,
+		VariableServer,
+		VariableRegion,
+	}
+}
+
 // CatalogVariable allows dynamically to replace variables represented with `{{VAR_NAME}}` string.
 type CatalogVariable interface {
 	GetSubstitutionPlan() SubstitutionPlan
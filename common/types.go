@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/amp-labs/connectors/internal/datautils"
@@ -115,7 +116,31 @@ type ReadParams struct {
 	//	* Klaviyo: comma separated methods following JSON:API filtering syntax.
 	//		Note: timing is already handled by Since argument.
 	//		Reference: https://developers.klaviyo.com/en/docs/filtering_
+	//	* Marketo: comma separated program ids, required to read program members.
 	Filter string // optional
+
+	// AssociatedObjects lists related objects whose IDs are returned alongside each record, e.g. ["companies", "deals"].
+	// Supported by Hubspot. Associations are placed into ReadResultRow.Associations.
+	AssociatedObjects []string // optional
+
+	// PreserveFieldCase keys ReadResultRow.Fields exactly as fields were requested, e.g. "Foo__c".
+	// By default keys are lowercase. See FieldKeys for the mapping.
+	PreserveFieldCase bool // optional, defaults to false
+}
+
+// FieldKeys maps every requested field to the key used in ReadResultRow.Fields.
+func (p ReadParams) FieldKeys() map[string]string {
+	keys := make(map[string]string, len(p.Fields))
+
+	for _, field := range p.Fields.List() {
+		if p.PreserveFieldCase {
+			keys[field] = field
+		} else {
+			keys[field] = strings.ToLower(field)
+		}
+	}
+
+	return keys
 }
 
 // WriteParams defines how we are writing data to a SaaS API.
@@ -129,6 +154,10 @@ type WriteParams struct {
 	// RecordData is a JSON node representing the record of data we want to insert in the case of CREATE
 	// or fields of data we want to modify in case of an update
 	RecordData any // required
+
+	// Associations link the record to other records. The format is provider specific.
+	// Supported by Hubspot, see hubspot.Association.
+	Associations any // optional
 }
 
 // DeleteParams defines how we are deleting data in SaaS API.
@@ -170,6 +199,19 @@ type ReadResultRow struct {
 	Fields map[string]any `json:"fields"`
 	// Raw is the raw JSON response from the provider.
 	Raw map[string]any `json:"raw"`
+	// Associations maps associated object name to the list of related records.
+	// Populated only when ReadParams.AssociatedObjects is set.
+	Associations map[string][]Association `json:"associations,omitempty"`
+}
+
+// Association is a reference to a related record.
+type Association struct {
+	// ObjectId is the identifier of the associated record.
+	ObjectId string `json:"objectId"`
+	// AssociationType describes the relationship, its format is provider specific.
+	AssociationType string `json:"associationType,omitempty"`
+	// Raw is the provider response describing this association.
+	Raw map[string]any `json:"raw,omitempty"`
 }
 
 // WriteResult is what's returned from writing data via the Write call.
@@ -241,6 +283,10 @@ type ObjectMetadata struct {
 
 	// FieldsMap is a map of field names to field display names
 	FieldsMap map[string]string
+
+	// FieldTypes is a map of field names to value types, only some connectors describe it.
+	// It is used to normalize values of ReadResultRow.Fields, see ReadResult.NormalizeValues.
+	FieldTypes FieldTypes
 }
 
 type PostAuthInfo struct {
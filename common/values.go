@@ -0,0 +1,254 @@
+package common
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ErrUnexpectedValue is returned when a field value cannot be converted into its declared ValueType.
+var ErrUnexpectedValue = errors.New("value doesn't match field type")
+
+// ValueType is a provider-neutral type of the field value.
+// Connectors translate types of their describe/schema endpoints into ValueType, see ObjectMetadata.FieldTypes.
+type ValueType string
+
+const (
+	// ValueTypeString is normalized into string.
+	ValueTypeString ValueType = "string"
+	// ValueTypeBoolean is normalized into bool.
+	ValueTypeBoolean ValueType = "boolean"
+	// ValueTypeInt is normalized into int64.
+	ValueTypeInt ValueType = "int"
+	// ValueTypeFloat is normalized into float64. Decimal, currency and percent fields use this type.
+	ValueTypeFloat ValueType = "float"
+	// ValueTypeDate is normalized into time.Time at midnight UTC.
+	ValueTypeDate ValueType = "date"
+	// ValueTypeDateTime is normalized into time.Time in UTC.
+	ValueTypeDateTime ValueType = "datetime"
+	// ValueTypeOther is any other type, ex: address or lookup object. Values are left as is.
+	ValueTypeOther ValueType = "other"
+)
+
+// FieldTypes is a map of field names to their value types.
+type FieldTypes map[string]ValueType
+
+// Get returns the type of the field, field names are matched case-insensitively.
+// ValueTypeOther is returned for unknown fields.
+func (t FieldTypes) Get(field string) ValueType {
+	if valueType, ok := t[field]; ok {
+		return valueType
+	}
+
+	for name, valueType := range t {
+		if strings.EqualFold(name, field) {
+			return valueType
+		}
+	}
+
+	return ValueTypeOther
+}
+
+// dateTimeLayouts are formats of timestamps used by providers.
+var dateTimeLayouts = []string{ // nolint:gochecknoglobals
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700", // Salesforce, ex: 2024-05-01T10:00:00.000+0000
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05", // Pipedrive, ex: 2024-05-01 10:00:00
+	time.DateOnly,
+}
+
+// moneyValueKeys are keys holding the amount of money objects, ex: {"value": 10, "currency": "USD"}.
+var moneyValueKeys = []string{"value", "amount"} // nolint:gochecknoglobals
+
+// NormalizeValues converts values of every row, see ReadResultRow.NormalizeValues.
+// This is opt-in, field types usually come from ObjectMetadata.FieldTypes.
+func (r *ReadResult) NormalizeValues(fieldTypes FieldTypes) error {
+	for index := range r.Data {
+		if err := r.Data[index].NormalizeValues(fieldTypes); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// NormalizeValues converts values of Fields into Go types described by ValueType,
+// so that records have the same types regardless of how the provider encodes them.
+// Raw is left unchanged.
+func (r *ReadResultRow) NormalizeValues(fieldTypes FieldTypes) error {
+	for field, value := range r.Fields {
+		normalized, err := NormalizeValue(value, fieldTypes.Get(field))
+		if err != nil {
+			return fmt.Errorf("field %v: %w", field, err)
+		}
+
+		r.Fields[field] = normalized
+	}
+
+	return nil
+}
+
+// NormalizeValue converts a single value into the Go type of ValueType.
+// Null values and empty strings of non-string types become nil.
+//
+//	->	Numbers and booleans encoded as strings are parsed, ex: "42" or "true".
+//	->	Money objects are reduced to their amount, ex: {"value": 10, "currency": "USD"}.
+//	->	Timestamps are parsed from ISO strings or Unix milliseconds.
+func NormalizeValue(value any, valueType ValueType) (any, error) {
+	if value == nil {
+		return nil, nil // nolint:nilnil
+	}
+
+	if text, ok := value.(string); ok && text == "" && valueType != ValueTypeString {
+		return nil, nil // nolint:nilnil
+	}
+
+	var (
+		normalized any
+		err        error
+	)
+
+	switch valueType {
+	case ValueTypeString:
+		normalized, err = toString(value)
+	case ValueTypeBoolean:
+		normalized, err = toBoolean(value)
+	case ValueTypeInt:
+		normalized, err = toInt(value)
+	case ValueTypeFloat:
+		normalized, err = toFloat(value)
+	case ValueTypeDate:
+		normalized, err = toDate(value)
+	case ValueTypeDateTime:
+		normalized, err = toDateTime(value)
+	default:
+		// ValueTypeOther is left as is.
+		return value, nil
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v is not %v", ErrUnexpectedValue, value, valueType)
+	}
+
+	return normalized, nil
+}
+
+func toString(value any) (string, error) {
+	switch typed := value.(type) {
+	case string:
+		return typed, nil
+	case bool:
+		return strconv.FormatBool(typed), nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	case json.Number:
+		return typed.String(), nil
+	case int, int64:
+		return fmt.Sprint(typed), nil
+	default:
+		return "", ErrUnexpectedValue
+	}
+}
+
+func toBoolean(value any) (bool, error) {
+	switch typed := value.(type) {
+	case bool:
+		return typed, nil
+	case string:
+		return strconv.ParseBool(typed)
+	default:
+		number, err := toFloat(value)
+		if err != nil {
+			return false, err
+		}
+
+		return number != 0, nil
+	}
+}
+
+func toInt(value any) (int64, error) {
+	switch typed := value.(type) {
+	case int64:
+		return typed, nil
+	case int:
+		return int64(typed), nil
+	case string:
+		if number, err := strconv.ParseInt(typed, 10, 64); err == nil {
+			return number, nil
+		}
+	case json.Number:
+		if number, err := typed.Int64(); err == nil {
+			return number, nil
+		}
+	}
+
+	number, err := toFloat(value)
+	if err != nil {
+		return 0, err
+	}
+
+	if number != math.Trunc(number) {
+		return 0, ErrUnexpectedValue
+	}
+
+	return int64(number), nil
+}
+
+func toFloat(value any) (float64, error) {
+	switch typed := value.(type) {
+	case float64:
+		return typed, nil
+	case int64:
+		return float64(typed), nil
+	case int:
+		return float64(typed), nil
+	case json.Number:
+		return typed.Float64()
+	case string:
+		return strconv.ParseFloat(typed, 64)
+	case map[string]any:
+		for _, key := range moneyValueKeys {
+			if amount, ok := typed[key]; ok {
+				return toFloat(amount)
+			}
+		}
+	}
+
+	return 0, ErrUnexpectedValue
+}
+
+func toDate(value any) (time.Time, error) {
+	timestamp, err := toDateTime(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return timestamp.Truncate(24 * time.Hour), nil // nolint:gomnd
+}
+
+func toDateTime(value any) (time.Time, error) {
+	if text, ok := value.(string); ok {
+		for _, layout := range dateTimeLayouts {
+			if timestamp, err := time.Parse(layout, text); err == nil {
+				return timestamp.UTC(), nil
+			}
+		}
+	}
+
+	if timestamp, ok := value.(time.Time); ok {
+		return timestamp.UTC(), nil
+	}
+
+	// Otherwise, expecting Unix milliseconds.
+	milliseconds, err := toInt(value)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	return time.UnixMilli(milliseconds).UTC(), nil
+}
@@ -0,0 +1,150 @@
+package common
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+)
+
+func TestNormalizeValue(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []struct {
+		name        string
+		value       any
+		valueType   ValueType
+		expected    any
+		expectedErr error
+	}{
+		{
+			name:      "HubSpot number encoded as string",
+			value:     "42.5",
+			valueType: ValueTypeFloat,
+			expected:  42.5,
+		},
+		{
+			name:      "Integer from JSON number",
+			value:     float64(7),
+			valueType: ValueTypeInt,
+			expected:  int64(7),
+		},
+		{
+			name:        "Fractional number is not an integer",
+			value:       7.5,
+			valueType:   ValueTypeInt,
+			expectedErr: ErrUnexpectedValue,
+		},
+		{
+			name:      "Boolean encoded as string",
+			value:     "true",
+			valueType: ValueTypeBoolean,
+			expected:  true,
+		},
+		{
+			name:      "Pipedrive money object is reduced to amount",
+			value:     map[string]any{"value": float64(1500), "currency": "USD"},
+			valueType: ValueTypeFloat,
+			expected:  float64(1500),
+		},
+		{
+			name:      "Salesforce datetime with offset without colon",
+			value:     "2024-05-01T10:00:00.000+0200",
+			valueType: ValueTypeDateTime,
+			expected:  time.Date(2024, 5, 1, 8, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Unix milliseconds",
+			value:     float64(1714557600000),
+			valueType: ValueTypeDateTime,
+			expected:  time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Date is truncated to midnight",
+			value:     "1714557600000",
+			valueType: ValueTypeDate,
+			expected:  time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Pipedrive timestamp without zone",
+			value:     "2024-05-01 10:00:00",
+			valueType: ValueTypeDateTime,
+			expected:  time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		},
+		{
+			name:      "Empty string of number type is null",
+			value:     "",
+			valueType: ValueTypeFloat,
+			expected:  nil,
+		},
+		{
+			name:      "Number as string type",
+			value:     float64(12),
+			valueType: ValueTypeString,
+			expected:  "12",
+		},
+		{
+			name:      "Other values are left as is",
+			value:     map[string]any{"city": "Paris"},
+			valueType: ValueTypeOther,
+			expected:  map[string]any{"city": "Paris"},
+		},
+		{
+			name:        "Unparsable timestamp",
+			value:       "yesterday",
+			valueType:   ValueTypeDateTime,
+			expectedErr: ErrUnexpectedValue,
+		},
+	}
+
+	for _, tt := range tests {
+		output, err := NormalizeValue(tt.value, tt.valueType)
+		if !errors.Is(err, tt.expectedErr) {
+			t.Fatalf("%s: expected error: (%v), got: (%v)", tt.name, tt.expectedErr, err)
+		}
+
+		if !reflect.DeepEqual(output, tt.expected) {
+			t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, output)
+		}
+	}
+}
+
+func TestReadResultNormalizeValues(t *testing.T) {
+	t.Parallel()
+
+	result := &ReadResult{
+		Data: []ReadResultRow{{
+			Fields: map[string]any{
+				"Amount":     "99.90",
+				"closedate":  "2024-05-01T10:00:00Z",
+				"unknown":    "as is",
+				"hs_deleted": "false",
+			},
+			Raw: map[string]any{"Amount": "99.90"},
+		}},
+	}
+
+	err := result.NormalizeValues(FieldTypes{
+		"amount":     ValueTypeFloat,
+		"closedate":  ValueTypeDateTime,
+		"hs_deleted": ValueTypeBoolean,
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: (%v)", err)
+	}
+
+	expected := map[string]any{
+		"Amount":     99.9,
+		"closedate":  time.Date(2024, 5, 1, 10, 0, 0, 0, time.UTC),
+		"unknown":    "as is",
+		"hs_deleted": false,
+	}
+
+	if !reflect.DeepEqual(result.Data[0].Fields, expected) {
+		t.Fatalf("expected: (%v), got: (%v)", expected, result.Data[0].Fields)
+	}
+
+	if result.Data[0].Raw["Amount"] != "99.90" {
+		t.Fatalf("raw record must not change, got: (%v)", result.Data[0].Raw)
+	}
+}
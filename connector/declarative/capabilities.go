@@ -0,0 +1,41 @@
+package declarative
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+var writeOperations = []common.Operation{ // nolint:gochecknoglobals
+	common.OperationCreate,
+	common.OperationUpdate,
+	common.OperationDelete,
+}
+
+// Capabilities is derived from the schema of every object in the selected module.
+// Objects which don't list operations are assumed to support create, update and delete.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	capabilities := common.NewCapabilities()
+
+	for name, object := range c.schemas.Modules[moduleIdentifier(c.module)].Objects {
+		features := common.ReadCapabilities{
+			Incremental: object.Since != nil,
+			Pagination:  common.PaginationNone,
+		}
+
+		if object.Pagination != nil {
+			features.Pagination = object.Pagination.Style
+			features.MaxPageSize = object.Pagination.PageSize
+		}
+
+		capabilities.WithRead(features, name)
+
+		for _, operation := range writeOperations {
+			if _, err := lookupMethod(&object, name, operation); err == nil {
+				capabilities.WithOperation(operation, name)
+			}
+		}
+	}
+
+	return capabilities, nil
+}
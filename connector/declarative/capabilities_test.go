@@ -0,0 +1,59 @@
+package declarative
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	connector, err := constructTestConnector(t, "http://localhost")
+	if err != nil {
+		t.Fatalf("failed to construct test connector: %v", err)
+	}
+
+	capabilities, err := connector.Capabilities(context.Background())
+
+	expected := &common.Capabilities{
+		Objects: map[string]common.ObjectCapabilities{
+			"contacts": {
+				Read:   true,
+				Create: true,
+				Update: true,
+				Delete: true,
+				ReadCapabilities: common.ReadCapabilities{
+					Incremental: true,
+					Pagination:  common.PaginationCursor,
+					MaxPageSize: 100,
+				},
+			},
+			"tasks": {
+				Read:   true,
+				Create: true,
+				Update: true,
+				Delete: false,
+				ReadCapabilities: common.ReadCapabilities{
+					Incremental: true,
+					Pagination:  common.PaginationOffset,
+					MaxPageSize: 2,
+				},
+			},
+			"notes": {
+				Read:   true,
+				Create: true,
+				Update: true,
+				Delete: true,
+				ReadCapabilities: common.ReadCapabilities{
+					Incremental: false,
+					Pagination:  common.PaginationNextURL,
+				},
+			},
+		},
+	}
+
+	testutils.CheckOutputWithError(t, "Capabilities", expected, nil, capabilities, err)
+}
@@ -0,0 +1,141 @@
+// Package declarative implements a connector which is driven by a static schema file.
+// Schema describes every object: URL path, location of records in the response,
+// pagination, incremental reading and record identifier.
+// This allows supporting REST APIs without provider specific Go code.
+package declarative
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/paramsbuilder"
+	"github.com/amp-labs/connectors/internal/staticschema"
+	"github.com/amp-labs/connectors/providers"
+)
+
+var (
+	// ErrInvalidSchemas is returned when schemas file cannot be parsed.
+	ErrInvalidSchemas = errors.New("invalid schemas file")
+
+	// ErrUnknownPagination is returned when pagination style is not supported.
+	ErrUnknownPagination = errors.New("unknown pagination style")
+
+	// ErrUnknownMethod is returned when write operation uses HTTP method other than POST, PUT or PATCH.
+	ErrUnknownMethod = errors.New("unknown write method")
+)
+
+type Connector struct {
+	ProviderInfo *providers.ProviderInfo
+	Client       *common.JSONHTTPClient
+	provider     providers.Provider
+	module       common.ModuleID
+	schemas      *staticschema.Metadata
+}
+
+func NewConnector(
+	provider providers.Provider,
+	opts ...Option,
+) (conn *Connector, outErr error) {
+	defer common.PanicRecovery(func(cause error) {
+		outErr = cause
+		conn = nil
+	})
+
+	params, err := paramsbuilder.Apply(parameters{provider: provider}, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := parseSchemas(params.schemas, params.module)
+	if err != nil {
+		return nil, err
+	}
+
+	conn = &Connector{
+		provider: params.provider,
+		module:   params.module,
+		schemas:  schemas,
+		Client: &common.JSONHTTPClient{
+			HTTPClient: params.Client.Caller,
+		},
+	}
+
+	// Read provider info & replace catalog variables with given substitutions, if any
+	providerInfo, err := providers.ReadInfo(conn.provider, &params.Workspace)
+	if err != nil {
+		return nil, err
+	}
+
+	conn.ProviderInfo = providerInfo
+	conn.Client.HTTPClient.ErrorHandler = common.InterpretError
+	conn.setBaseURL(providerInfo.BaseURL)
+
+	return conn, nil
+}
+
+func (c *Connector) Provider() providers.Provider {
+	return c.provider
+}
+
+func (c *Connector) String() string {
+	return fmt.Sprintf("%s.Connector[declarative]", c.Provider())
+}
+
+func (c *Connector) setBaseURL(newURL string) {
+	c.ProviderInfo.BaseURL = newURL
+	c.Client.HTTPClient.Base = newURL
+}
+
+func parseSchemas(data []byte, module common.ModuleID) (*staticschema.Metadata, error) {
+	var schemas staticschema.Metadata
+	if err := json.Unmarshal(data, &schemas); err != nil {
+		return nil, errors.Join(ErrInvalidSchemas, err)
+	}
+
+	if _, ok := schemas.ObjectNames()[module]; !ok {
+		return nil, fmt.Errorf("%w: module [%v] is not defined", ErrInvalidSchemas, module)
+	}
+
+	for name, object := range schemas.Modules[moduleIdentifier(module)].Objects {
+		if err := validatePagination(object.Pagination); err != nil {
+			return nil, fmt.Errorf("%w: object [%v]: %w", ErrInvalidSchemas, name, err)
+		}
+	}
+
+	return &schemas, nil
+}
+
+func validatePagination(pagination *staticschema.Pagination) error {
+	if pagination == nil {
+		return nil
+	}
+
+	switch pagination.Style {
+	case common.PaginationNone:
+		return nil
+	case common.PaginationCursor, common.PaginationNextURL:
+		if pagination.ResponsePath == "" {
+			return fmt.Errorf("%w: %v requires responsePath", ErrUnknownPagination, pagination.Style)
+		}
+	case common.PaginationOffset, common.PaginationPage:
+	default:
+		return fmt.Errorf("%w: %v", ErrUnknownPagination, pagination.Style)
+	}
+
+	if pagination.Style != common.PaginationNextURL && pagination.RequestParam == "" {
+		return fmt.Errorf("%w: %v requires requestParam", ErrUnknownPagination, pagination.Style)
+	}
+
+	return nil
+}
+
+// moduleIdentifier mirrors staticschema convention, where empty module is the root module.
+func moduleIdentifier(module common.ModuleID) common.ModuleID {
+	if module == "" {
+		return staticschema.RootModuleID
+	}
+
+	return module
+}
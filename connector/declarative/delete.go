@@ -0,0 +1,37 @@
+package declarative
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Delete removes a record using DELETE to the object path followed by record id.
+// Objects listing their operations must include delete operation.
+func (c *Connector) Delete(ctx context.Context, config common.DeleteParams) (*common.DeleteResult, error) {
+	if err := config.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	object, err := c.lookupObject(config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = lookupMethod(object, config.ObjectName, common.OperationDelete); err != nil {
+		return nil, err
+	}
+
+	url, err := c.getObjectURL(config.ObjectName, config.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err = c.Client.Delete(ctx, url.String()); err != nil {
+		return nil, err
+	}
+
+	return &common.DeleteResult{
+		Success: true,
+	}, nil
+}
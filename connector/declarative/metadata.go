@@ -0,0 +1,14 @@
+package declarative
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// ListObjectMetadata returns fields listed in the schemas file.
+func (c *Connector) ListObjectMetadata(
+	ctx context.Context, objectNames []string,
+) (*common.ListObjectMetadataResult, error) {
+	return c.schemas.Select(c.module, objectNames)
+}
@@ -0,0 +1,110 @@
+package declarative
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/staticschema"
+	"github.com/spyzhov/ajson"
+)
+
+const defaultIDField = "id"
+
+// lookupObject returns schema of the object from the selected module.
+func (c *Connector) lookupObject(objectName string) (*staticschema.Object, error) {
+	object, ok := c.schemas.Modules[moduleIdentifier(c.module)].Objects[objectName]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v", common.ErrOperationNotSupportedForObject, objectName)
+	}
+
+	return &object, nil
+}
+
+// conventionalMethods are used when the schema doesn't list object operations.
+var conventionalMethods = map[common.Operation]string{ // nolint:gochecknoglobals
+	common.OperationCreate: http.MethodPost,
+	common.OperationUpdate: http.MethodPatch,
+	common.OperationDelete: http.MethodDelete,
+}
+
+// lookupMethod returns HTTP method of the write operation.
+// Objects without operations in the schema follow REST conventions,
+// otherwise only listed operations are allowed.
+func lookupMethod(object *staticschema.Object, objectName string, operation common.Operation) (string, error) {
+	if object.Operations == nil {
+		return conventionalMethods[operation], nil
+	}
+
+	details, ok := object.Operations.Lookup(operation)
+	if !ok {
+		return "", fmt.Errorf("%w: %v %v", common.ErrOperationNotSupportedForObject, operation, objectName)
+	}
+
+	if details.Method == "" {
+		return conventionalMethods[operation], nil
+	}
+
+	return details.Method, nil
+}
+
+func (c *Connector) getObjectURL(objectName string, recordID string) (*urlbuilder.URL, error) {
+	path, err := c.schemas.LookupURLPath(c.module, objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := urlbuilder.New(c.ProviderInfo.BaseURL, path)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(recordID) != 0 {
+		url.AddPath(recordID)
+	}
+
+	return url, nil
+}
+
+// resolveURL supports next page links that are relative to the base URL.
+func (c *Connector) resolveURL(link string) (*urlbuilder.URL, error) {
+	if strings.HasPrefix(link, "http://") || strings.HasPrefix(link, "https://") {
+		return urlbuilder.New(link)
+	}
+
+	base, err := url.Parse(c.ProviderInfo.BaseURL)
+	if err != nil {
+		return nil, err
+	}
+
+	reference, err := url.Parse(link)
+	if err != nil {
+		return nil, err
+	}
+
+	return urlbuilder.New(base.ResolveReference(reference).String())
+}
+
+func getIDField(object *staticschema.Object) string {
+	if object.IDField == "" {
+		return defaultIDField
+	}
+
+	return object.IDField
+}
+
+// queryPath converts dot separated path into jsonquery.
+// Ex: "meta.next_cursor" zooms into "meta" object and targets "next_cursor" key.
+func queryPath(node *ajson.Node, path string) (*jsonquery.Query, string) {
+	if path == "" {
+		return jsonquery.New(node), ""
+	}
+
+	parts := strings.Split(path, ".")
+
+	return jsonquery.New(node, parts[:len(parts)-1]...), parts[len(parts)-1]
+}
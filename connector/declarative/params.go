@@ -0,0 +1,84 @@
+package declarative
+
+import (
+	"context"
+	"errors"
+	"net/http"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/paramsbuilder"
+	"github.com/amp-labs/connectors/providers"
+	"golang.org/x/oauth2"
+)
+
+var (
+	// ErrMissingProvider is returned when a connector is created without a provider.
+	ErrMissingProvider = errors.New("missing provider")
+
+	// ErrMissingSchemas is returned when a connector is created without schemas file.
+	ErrMissingSchemas = errors.New("missing schemas")
+)
+
+type Option = func(*parameters)
+
+type parameters struct {
+	provider providers.Provider
+	paramsbuilder.Client
+	paramsbuilder.Workspace
+
+	schemas []byte
+	module  common.ModuleID
+}
+
+func (p parameters) ValidateParams() error {
+	if p.provider == "" {
+		return ErrMissingProvider
+	}
+
+	if len(p.schemas) == 0 {
+		return ErrMissingSchemas
+	}
+
+	// workspace is optional
+
+	return errors.Join(
+		p.Client.ValidateParams(),
+	)
+}
+
+// WithClient sets the http client to use for the connector.
+func WithClient(ctx context.Context, client *http.Client,
+	config *oauth2.Config, token *oauth2.Token, opts ...common.OAuthOption,
+) Option {
+	return func(params *parameters) {
+		params.WithOauthClient(ctx, client, config, token, opts...)
+	}
+}
+
+// WithAuthenticatedClient sets the http client to use for the connector. Its usage is optional.
+func WithAuthenticatedClient(client common.AuthenticatedHTTPClient) Option {
+	return func(params *parameters) {
+		params.WithAuthenticatedClient(client)
+	}
+}
+
+// WithWorkspace sets workspace which is used as substitution for URL templates.
+func WithWorkspace(workspaceRef string) Option {
+	return func(params *parameters) {
+		params.WithWorkspace(workspaceRef)
+	}
+}
+
+// WithSchemas sets content of schemas.json file describing objects. It's required.
+func WithSchemas(schemas []byte) Option {
+	return func(params *parameters) {
+		params.schemas = schemas
+	}
+}
+
+// WithModule selects module within schemas file. Defaults to the root module.
+func WithModule(module common.ModuleID) Option {
+	return func(params *parameters) {
+		params.module = module
+	}
+}
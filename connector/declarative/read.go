@@ -0,0 +1,157 @@
+package declarative
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/staticschema"
+	"github.com/spyzhov/ajson"
+)
+
+// Read reads a page of records. Object schema dictates where records are located,
+// how the next page is requested and whether Since is supported.
+func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
+	if err := config.ValidateParams(true); err != nil {
+		return nil, err
+	}
+
+	object, err := c.lookupObject(config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := c.buildReadURL(config, object)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(rsp,
+		makeRecordsGetter(object),
+		makeNextPageGetter(object, config),
+		common.GetMarshaledData,
+		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
+	)
+}
+
+func (c *Connector) buildReadURL(config common.ReadParams, object *staticschema.Object) (*urlbuilder.URL, error) {
+	pagination := object.Pagination
+
+	if len(config.NextPage) != 0 && pagination != nil && pagination.Style == common.PaginationNextURL {
+		// Next page URL already has all query parameters.
+		return c.resolveURL(config.NextPage.String())
+	}
+
+	url, err := c.getObjectURL(config.ObjectName, "")
+	if err != nil {
+		return nil, err
+	}
+
+	if pagination != nil {
+		if pagination.PageSizeParam != "" && pagination.PageSize != 0 {
+			url.WithQueryParam(pagination.PageSizeParam, strconv.Itoa(pagination.PageSize))
+		}
+
+		if len(config.NextPage) != 0 {
+			url.WithQueryParam(pagination.RequestParam, config.NextPage.String())
+		}
+	}
+
+	if object.Since != nil && !config.Since.IsZero() {
+		url.WithQueryParam(object.Since.QueryParam, formatSince(config.Since, object.Since.Format))
+	}
+
+	return url, nil
+}
+
+func formatSince(since time.Time, format string) string {
+	switch format {
+	case "unix":
+		return strconv.FormatInt(since.Unix(), 10)
+	case "unixMilli":
+		return strconv.FormatInt(since.UnixMilli(), 10)
+	default:
+		return since.UTC().Format(time.RFC3339)
+	}
+}
+
+func makeRecordsGetter(object *staticschema.Object) common.RecordsFunc {
+	return func(node *ajson.Node) ([]map[string]any, error) {
+		arr, err := getRecordNodes(node, object)
+		if err != nil {
+			return nil, err
+		}
+
+		return jsonquery.Convertor.ArrayToMap(arr)
+	}
+}
+
+func getRecordNodes(node *ajson.Node, object *staticschema.Object) ([]*ajson.Node, error) {
+	query, key := queryPath(node, object.ResponseKey)
+
+	return query.Array(key, true)
+}
+
+// makeNextPageGetter produces the token of the following page.
+// Cursor and next URL are taken from the response.
+// Offset and page number are computed, reading stops once a page is not full.
+func makeNextPageGetter(object *staticschema.Object, config common.ReadParams) common.NextPageFunc {
+	return func(node *ajson.Node) (string, error) {
+		pagination := object.Pagination
+		if pagination == nil {
+			return "", nil
+		}
+
+		switch pagination.Style {
+		case common.PaginationCursor, common.PaginationNextURL:
+			query, key := queryPath(node, pagination.ResponsePath)
+
+			return query.TextWithDefault(key, "")
+		case common.PaginationOffset, common.PaginationPage:
+			return nextCountingPage(node, object, config)
+		default:
+			return "", nil
+		}
+	}
+}
+
+func nextCountingPage(node *ajson.Node, object *staticschema.Object, config common.ReadParams) (string, error) {
+	records, err := getRecordNodes(node, object)
+	if err != nil {
+		return "", err
+	}
+
+	pagination := object.Pagination
+
+	if len(records) == 0 || (pagination.PageSize != 0 && len(records) < pagination.PageSize) {
+		return "", nil
+	}
+
+	current := 0
+	if len(config.NextPage) != 0 {
+		current, err = strconv.Atoi(config.NextPage.String())
+		if err != nil {
+			return "", err
+		}
+	}
+
+	if pagination.Style == common.PaginationOffset {
+		return strconv.Itoa(current + len(records)), nil
+	}
+
+	// The first page is requested without page number, which is page one.
+	if current == 0 {
+		current = 1
+	}
+
+	return strconv.Itoa(current + 1), nil
+}
@@ -0,0 +1,260 @@
+package declarative
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responseContacts := testutils.DataFromFile(t, "contacts-read.json")
+	responseTasks := testutils.DataFromFile(t, "tasks-read.json")
+	responseNotes := testutils.DataFromFile(t, "notes-read.json")
+
+	tests := []testroutines.Read{
+		{
+			Name:         "Read object must be included",
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjects},
+		},
+		{
+			Name:         "Object must be described in schemas",
+			Input:        common.ReadParams{ObjectName: "butterflies", Fields: connectors.Fields("id")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name: "Cursor pagination with since filter",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("name"),
+				Since:      time.Date(2024, 5, 1, 0, 0, 0, 0, time.UTC),
+				NextPage:   "Y3Vyc29yOjE=",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/v1/contacts"),
+					mockcond.QueryParam("limit", "100"),
+					mockcond.QueryParam("cursor", "Y3Vyc29yOjE="),
+					mockcond.QueryParam("updated_since", "2024-05-01T00:00:00Z"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseContacts),
+			}.Server(),
+			Comparator: readComparator,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"name": "Ada Lovelace"},
+				}, {
+					Fields: map[string]any{"name": "Alan Turing"},
+				}},
+				NextPage: "Y3Vyc29yOjI=",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Field case is preserved",
+			Input: common.ReadParams{
+				ObjectName:        "contacts",
+				Fields:            connectors.Fields("Name"),
+				PreserveFieldCase: true,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/v1/contacts"),
+				Then:  mockserver.Response(http.StatusOK, responseContacts),
+			}.Server(),
+			Comparator: readComparator,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"Name": "Ada Lovelace"},
+				}, {
+					Fields: map[string]any{"Name": "Alan Turing"},
+				}},
+				NextPage: "Y3Vyc29yOjI=",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Offset is advanced by number of records on a full page",
+			Input: common.ReadParams{
+				ObjectName: "tasks",
+				Fields:     connectors.Fields("title"),
+				NextPage:   "2",
+				Since:      time.Unix(1714521600, 0),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/v1/tasks"),
+					mockcond.QueryParam("limit", "2"),
+					mockcond.QueryParam("offset", "2"),
+					mockcond.QueryParam("modified_after", "1714521600"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseTasks),
+			}.Server(),
+			Comparator: readComparator,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"title": "Call back"},
+				}, {
+					Fields: map[string]any{"title": "Send proposal"},
+				}},
+				NextPage: "4",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:  "Empty page completes offset pagination",
+			Input: common.ReadParams{ObjectName: "tasks", Fields: connectors.Fields("title")},
+			Server: mockserver.Fixed{
+				Setup:  mockserver.ContentJSON(),
+				Always: mockserver.ResponseString(http.StatusOK, `[]`),
+			}.Server(),
+			Expected:     &common.ReadResult{Rows: 0, Data: []common.ReadResultRow{}, Done: true},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:  "Relative next page link is resolved against base URL",
+			Input: common.ReadParams{ObjectName: "notes", Fields: connectors.Fields("body")},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/v1/notes"),
+				Then:  mockserver.Response(http.StatusOK, responseNotes),
+			}.Server(),
+			Comparator: readComparator,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"body": "Met at conference"},
+				}},
+				NextPage: "/v1/notes?page_token=abc",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page link is followed as is",
+			Input: common.ReadParams{
+				ObjectName: "notes",
+				Fields:     connectors.Fields("body"),
+				NextPage:   "/v1/notes?page_token=abc",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/v1/notes"),
+					mockcond.QueryParam("page_token", "abc"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{"items":[],"links":{}}`),
+			}.Server(),
+			Expected:     &common.ReadResult{Rows: 0, Data: []common.ReadResultRow{}, Done: true},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(t, tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestNewConnectorValidatesSchemas(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		schemas  string
+		expected []error
+	}{
+		{
+			name:     "Schemas are required",
+			schemas:  "",
+			expected: []error{ErrMissingSchemas},
+		},
+		{
+			name:     "Schemas must be JSON",
+			schemas:  "modules",
+			expected: []error{ErrInvalidSchemas},
+		},
+		{
+			name:     "Default module must exist",
+			schemas:  `{"modules":{}}`,
+			expected: []error{ErrInvalidSchemas},
+		},
+		{
+			name: "Pagination style must be known",
+			schemas: `{"modules":{"root":{"objects":{"contacts":{"path":"/contacts",` +
+				`"pagination":{"style":"random"}}}}}}`,
+			expected: []error{ErrInvalidSchemas, ErrUnknownPagination},
+		},
+		{
+			name: "Cursor pagination needs location of the cursor",
+			schemas: `{"modules":{"root":{"objects":{"contacts":{"path":"/contacts",` +
+				`"pagination":{"style":"cursor","requestParam":"cursor"}}}}}}`,
+			expected: []error{ErrInvalidSchemas, ErrUnknownPagination},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := NewConnector(providers.Smartlead,
+				WithAuthenticatedClient(http.DefaultClient),
+				WithSchemas([]byte(tt.schemas)),
+			)
+			testutils.CheckErrors(t, tt.name, tt.expected, err)
+		})
+	}
+}
+
+func readComparator(baseURL string, actual, expected *common.ReadResult) bool {
+	return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+		actual.NextPage.String() == expected.NextPage.String() &&
+		actual.Rows == expected.Rows &&
+		actual.Done == expected.Done
+}
+
+func constructTestConnector(t *testing.T, serverURL string) (*Connector, error) {
+	t.Helper()
+
+	connector, err := NewConnector(providers.Smartlead,
+		WithAuthenticatedClient(http.DefaultClient),
+		WithSchemas(testutils.DataFromFile(t, "schemas.json")),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// for testing we want to redirect calls to our mock server
+	connector.setBaseURL(serverURL)
+
+	return connector, nil
+}
@@ -0,0 +1,101 @@
+package declarative
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/amp-labs/connectors/internal/staticschema"
+	"github.com/spyzhov/ajson"
+)
+
+// Write creates a record by calling the object path,
+// or updates it by calling the object path followed by record id.
+// HTTP methods come from object operations, by default POST and PATCH are used.
+func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
+	if err := config.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	object, err := c.lookupObject(config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	operation := common.OperationCreate
+	if len(config.RecordId) != 0 {
+		operation = common.OperationUpdate
+	}
+
+	method, err := lookupMethod(object, config.ObjectName, operation)
+	if err != nil {
+		return nil, err
+	}
+
+	write, err := c.selectWriteMethod(method)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := c.getObjectURL(config.ObjectName, config.RecordId)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := write(ctx, url.String(), config.RecordData)
+	if err != nil {
+		return nil, err
+	}
+
+	body, ok := rsp.Body()
+	if !ok {
+		// Provider acknowledged the write without payload.
+		return &common.WriteResult{
+			Success:  true,
+			RecordId: config.RecordId,
+		}, nil
+	}
+
+	return constructWriteResult(body, object)
+}
+
+func (c *Connector) selectWriteMethod(method string) (common.WriteMethod, error) {
+	switch method {
+	case http.MethodPost:
+		return c.Client.Post, nil
+	case http.MethodPut:
+		return c.Client.Put, nil
+	case http.MethodPatch:
+		return c.Client.Patch, nil
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnknownMethod, method)
+	}
+}
+
+func constructWriteResult(body *ajson.Node, object *staticschema.Object) (*common.WriteResult, error) {
+	query, key := queryPath(body, object.RecordKey)
+
+	record, err := query.Object(key, false)
+	if err != nil {
+		return nil, err
+	}
+
+	recordID, err := jsonquery.New(record).TextWithDefault(getIDField(object), "")
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := jsonquery.Convertor.ObjectToMap(record)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.WriteResult{
+		Success:  true,
+		RecordId: recordID,
+		Errors:   nil,
+		Data:     data,
+	}, nil
+}
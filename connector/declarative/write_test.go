@@ -0,0 +1,167 @@
+package declarative
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestWrite(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseContact := testutils.DataFromFile(t, "contacts-write.json")
+
+	tests := []testroutines.Write{
+		{
+			Name:         "Object must be described in schemas",
+			Input:        common.WriteParams{ObjectName: "butterflies", RecordData: "dummy"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name:  "Create uses POST and finds record under record key",
+			Input: common.WriteParams{ObjectName: "contacts", RecordData: map[string]any{"name": "Grace Hopper"}},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/v1/contacts"),
+					mockcond.Body(`{"name":"Grace Hopper"}`),
+				},
+				Then: mockserver.Response(http.StatusOK, responseContact),
+			}.Server(),
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "3",
+				Data: map[string]any{
+					"id":         float64(3),
+					"name":       "Grace Hopper",
+					"updated_at": "2024-05-03T10:00:00Z",
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:  "Update uses PATCH with custom id field",
+			Input: common.WriteParams{ObjectName: "notes", RecordId: "n-1", RecordData: map[string]any{"body": "Updated"}},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPATCH(),
+					mockcond.PathSuffix("/v1/notes/n-1"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{"note_id":"n-1","body":"Updated"}`),
+			}.Server(),
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "n-1",
+				Data:     map[string]any{"note_id": "n-1", "body": "Updated"},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:  "Empty response keeps record id, update uses method from operations",
+			Input: common.WriteParams{ObjectName: "tasks", RecordId: "t-3", RecordData: map[string]any{"title": "Done"}},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPUT(),
+					mockcond.PathSuffix("/v1/tasks/t-3"),
+				},
+				Then: mockserver.Response(http.StatusNoContent),
+			}.Server(),
+			Expected:     &common.WriteResult{Success: true, RecordId: "t-3"},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.WriteConnector, error) {
+				return constructTestConnector(t, tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestDelete(t *testing.T) {
+	t.Parallel()
+
+	tests := []testroutines.Delete{
+		{
+			Name:  "Delete uses object path followed by record id",
+			Input: common.DeleteParams{ObjectName: "contacts", RecordId: "3"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodDELETE(),
+					mockcond.PathSuffix("/v1/contacts/3"),
+				},
+				Then: mockserver.Response(http.StatusNoContent),
+			}.Server(),
+			Expected:     &common.DeleteResult{Success: true},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:         "Delete must be listed in object operations",
+			Input:        common.DeleteParams{ObjectName: "tasks", RecordId: "t-3"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.DeleteConnector, error) {
+				return constructTestConnector(t, tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestListObjectMetadata(t *testing.T) {
+	t.Parallel()
+
+	tests := []testroutines.Metadata{
+		{
+			Name:   "Fields are served from schemas",
+			Input:  []string{"notes"},
+			Server: mockserver.Dummy(),
+			Expected: &common.ListObjectMetadataResult{
+				Result: map[string]common.ObjectMetadata{
+					"notes": {
+						DisplayName: "Notes",
+						FieldsMap:   map[string]string{"note_id": "note_id", "body": "body"},
+					},
+				},
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ObjectMetadataConnector, error) {
+				return constructTestConnector(t, tt.Server.URL)
+			})
+		})
+	}
+}
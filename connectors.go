@@ -88,15 +88,55 @@ type AuthMetadataConnector interface {
 	GetPostAuthInfo(ctx context.Context) (*common.PostAuthInfo, error)
 }
 
+// CapabilitiesConnector is an interface that extends the Connector interface with
+// the ability to describe which operations and read features are supported per object.
+// Callers can use it to choose between full and incremental reads without provider-specific knowledge.
+type CapabilitiesConnector interface {
+	Connector
+
+	Capabilities(ctx context.Context) (*Capabilities, error)
+}
+
+// SearchConnector is an interface that extends the Connector interface with
+// the ability to find records matching a provider-neutral filter, ex: a contact by email.
+// Filters and sorting are translated into the query language of the provider.
+// Shapes or operators the provider cannot express are rejected with
+// common.ErrUnsupportedSearchFilter or common.ErrUnsupportedSearchOperator.
+type SearchConnector interface {
+	Connector
+
+	SearchRecords(ctx context.Context, params SearchParams) (*ReadResult, error)
+}
+
+// RecordConnector is an interface that extends the Connector interface with
+// the ability to fetch records by their IDs, ex: to hydrate webhook payloads or to verify writes.
+// Batch endpoints of the provider are used when they exist.
+// Fields of returned rows are normalized the same way as by Read.
+type RecordConnector interface {
+	Connector
+
+	// GetRecordByID returns common.ErrRecordNotFound if there is no record with given ID.
+	GetRecordByID(ctx context.Context, params GetRecordParams) (*ReadResultRow, error)
+	// GetRecords omits records which don't exist, the order of rows is not guaranteed.
+	GetRecords(ctx context.Context, params GetRecordsParams) ([]ReadResultRow, error)
+}
+
 // We re-export the following types so that they can be used by consumers of this library.
 type (
 	ReadParams               = common.ReadParams
 	WriteParams              = common.WriteParams
 	DeleteParams             = common.DeleteParams
+	SearchParams             = common.SearchParams
+	GetRecordParams          = common.GetRecordParams
+	GetRecordsParams         = common.GetRecordsParams
 	ReadResult               = common.ReadResult
+	ReadResultRow            = common.ReadResultRow
 	WriteResult              = common.WriteResult
 	DeleteResult             = common.DeleteResult
 	ListObjectMetadataResult = common.ListObjectMetadataResult
+	Capabilities             = common.Capabilities
+	FieldTypes               = common.FieldTypes
+	ValueType                = common.ValueType
 
 	ErrorWithStatus = common.HTTPStatusError
 )
@@ -0,0 +1,31 @@
+package staticschema
+
+import (
+	"github.com/amp-labs/connectors/common"
+)
+
+// Capabilities describes objects of the module, every object is readable.
+// Read features of each object are returned by readFeatures.
+// Write operations implemented by the connector are matched against Object.Operations.
+// Objects without operations follow REST conventions and support all of them, see Metadata.SetReadOnly.
+// NOTE: empty module id is treated as root module.
+func (r *Metadata) Capabilities(
+	moduleID common.ModuleID,
+	readFeatures func(objectName string) common.ReadCapabilities,
+	operations ...common.Operation,
+) *common.Capabilities {
+	moduleID = moduleIdentifier(moduleID)
+	capabilities := common.NewCapabilities()
+
+	for objectName, object := range r.Modules[moduleID].Objects {
+		capabilities.WithRead(readFeatures(objectName), objectName)
+
+		for _, operation := range operations {
+			if _, ok := object.Operations.Lookup(operation); ok || object.Operations == nil {
+				capabilities.WithOperation(operation, objectName)
+			}
+		}
+	}
+
+	return capabilities
+}
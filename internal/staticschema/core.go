@@ -47,8 +47,101 @@ type Object struct {
 	// FieldsMap is a map of field names to field display names
 	FieldsMap map[string]string `json:"fields"`
 
+	// FieldTypes is a map of field names to value types. Optional.
+	FieldTypes common.FieldTypes `json:"fieldTypes,omitempty"`
+
 	// DocsURL points to docs endpoint. Optional.
 	DocsURL *string `json:"docs,omitempty"`
+
+	// Below are optional hints for the schema-driven connector, see connector/declarative package.
+	// Deep connectors have this knowledge hardcoded and ignore these properties.
+
+	// IDField is the name of record identifier. Defaults to "id".
+	IDField string `json:"idField,omitempty"`
+
+	// RecordKey is a dot separated location of the record in create/update response.
+	// Empty value means the response is the record itself.
+	RecordKey string `json:"recordKey,omitempty"`
+
+	// Pagination describes how to move between pages when reading. Nil means no pagination.
+	Pagination *Pagination `json:"pagination,omitempty"`
+
+	// Since describes query parameter used for incremental reading. Nil means every read is a full read.
+	Since *SinceFilter `json:"since,omitempty"`
+
+	// Operations lists create, update and delete endpoints discovered in the OpenAPI file.
+	// Nil means write support is unknown.
+	Operations *Operations `json:"operations,omitempty"`
+}
+
+// Operations describes which write operations the object supports.
+// Record specific operations are performed on the object path followed by the record identifier.
+type Operations struct {
+	Create *Operation `json:"create,omitempty"`
+	Update *Operation `json:"update,omitempty"`
+	Delete *Operation `json:"delete,omitempty"`
+}
+
+// Operation describes a single write endpoint.
+type Operation struct {
+	// Method is the HTTP method, ex: POST, PUT, PATCH, DELETE.
+	Method string `json:"method"`
+
+	// RecordIDParam is the name of path parameter holding record identifier, ex: "contact_id".
+	// Empty for create.
+	RecordIDParam string `json:"recordIdParam,omitempty"`
+
+	// Fields are the properties of the request body.
+	Fields []string `json:"fields,omitempty"`
+}
+
+// Lookup returns operation details, if the object supports it.
+func (o *Operations) Lookup(operation common.Operation) (*Operation, bool) {
+	if o == nil {
+		return nil, false
+	}
+
+	var result *Operation
+
+	switch operation {
+	case common.OperationCreate:
+		result = o.Create
+	case common.OperationUpdate:
+		result = o.Update
+	case common.OperationDelete:
+		result = o.Delete
+	case common.OperationRead:
+	}
+
+	return result, result != nil
+}
+
+// Pagination describes how the next page is requested.
+type Pagination struct {
+	// Style is one of: "cursor", "offset", "page", "nextURL".
+	Style common.PaginationStyle `json:"style"`
+
+	// RequestParam is the query parameter which receives cursor, offset or page number.
+	RequestParam string `json:"requestParam,omitempty"`
+
+	// ResponsePath is a dot separated location of the cursor or next page URL in the response.
+	// Not used for offset and page styles.
+	ResponsePath string `json:"responsePath,omitempty"`
+
+	// PageSizeParam is the query parameter for page size, ex: "limit".
+	PageSizeParam string `json:"pageSizeParam,omitempty"`
+
+	// PageSize is the number of records requested per page.
+	PageSize int `json:"pageSize,omitempty"`
+}
+
+// SinceFilter describes how common.ReadParams.Since is sent to the provider.
+type SinceFilter struct {
+	// QueryParam is the name of the query parameter, ex: "updated_since".
+	QueryParam string `json:"queryParam"`
+
+	// Format is one of: "rfc3339" (default), "unix", "unixMilli".
+	Format string `json:"format,omitempty"`
 }
 
 // Add will appropriately store the data, abiding to data structure rules.
@@ -77,6 +170,80 @@ func (r *Metadata) Add(
 	data.FieldsMap[fieldName] = fieldName
 }
 
+// AddFieldTypes stores value types of fields for the object added beforehand via Metadata.Add.
+// Types of unknown objects are ignored.
+// NOTE: empty module id is treated as root module.
+func (r *Metadata) AddFieldTypes(
+	moduleID common.ModuleID, objectName string, fieldTypes common.FieldTypes,
+) {
+	moduleID = moduleIdentifier(moduleID)
+
+	object, ok := r.Modules[moduleID].Objects[objectName]
+	if !ok || len(fieldTypes) == 0 {
+		return
+	}
+
+	if object.FieldTypes == nil {
+		object.FieldTypes = make(common.FieldTypes)
+	}
+
+	for fieldName, valueType := range fieldTypes {
+		object.FieldTypes[fieldName] = valueType
+	}
+
+	r.Modules[moduleID].Objects[objectName] = object
+}
+
+// AddOperation stores write operation for the object added beforehand via Metadata.Add.
+// Operations of unknown objects are ignored, since the object path and display name are not known.
+// NOTE: empty module id is treated as root module.
+func (r *Metadata) AddOperation(
+	moduleID common.ModuleID, objectName string, operation common.Operation, details Operation,
+) {
+	moduleID = moduleIdentifier(moduleID)
+
+	object, ok := r.Modules[moduleID].Objects[objectName]
+	if !ok {
+		return
+	}
+
+	if object.Operations == nil {
+		object.Operations = &Operations{}
+	}
+
+	switch operation {
+	case common.OperationCreate:
+		object.Operations.Create = &details
+	case common.OperationUpdate:
+		object.Operations.Update = &details
+	case common.OperationDelete:
+		object.Operations.Delete = &details
+	case common.OperationRead:
+		return
+	}
+
+	r.Modules[moduleID].Objects[objectName] = object
+}
+
+// SetReadOnly assigns empty operations to objects of the module which have none.
+// Without it, objects are assumed to support every write operation following REST conventions.
+// NOTE: empty module id is treated as root module.
+func (r *Metadata) SetReadOnly(moduleID common.ModuleID) {
+	moduleID = moduleIdentifier(moduleID)
+
+	module, ok := r.Modules[moduleID]
+	if !ok {
+		return
+	}
+
+	for objectName, object := range module.Objects {
+		if object.Operations == nil {
+			object.Operations = &Operations{}
+			module.Objects[objectName] = object
+		}
+	}
+}
+
 func (r *Metadata) refactorLongestCommonPath() {
 	for moduleID, module := range r.Modules {
 		var (
@@ -142,6 +309,30 @@ func (r *Metadata) ObjectNames() datautils.UniqueLists[common.ModuleID, string]
 	return moduleObjectNames
 }
 
+// ObjectNamesByOperation provides a registry of object names, grouped by module,
+// which support create, update or delete according to the static file.
+func (r *Metadata) ObjectNamesByOperation(operation common.Operation) datautils.UniqueLists[common.ModuleID, string] {
+	moduleObjectNames := make(datautils.UniqueLists[common.ModuleID, string])
+
+	for key, value := range r.Modules {
+		names := datautils.NewStringSet()
+
+		for name, object := range value.Objects {
+			if _, ok := object.Operations.Lookup(operation); ok {
+				names.AddOne(name)
+			}
+		}
+
+		moduleObjectNames[key] = names
+
+		if key == RootModuleID {
+			moduleObjectNames[""] = names
+		}
+	}
+
+	return moduleObjectNames
+}
+
 // LookupURLPath will give you the URL path for the object located under the module.
 // NOTE: empty module id is treated as root module.
 func (r *Metadata) LookupURLPath(moduleID common.ModuleID, objectName string) (string, error) {
@@ -158,6 +349,16 @@ func (r *Metadata) LookupURLPath(moduleID common.ModuleID, objectName string) (s
 	return fullPath, nil
 }
 
+// LookupOperation returns details of create, update or delete operation for the object under the module.
+// NOTE: empty module id is treated as root module.
+func (r *Metadata) LookupOperation(
+	moduleID common.ModuleID, objectName string, operation common.Operation,
+) (*Operation, bool) {
+	moduleID = moduleIdentifier(moduleID)
+
+	return r.Modules[moduleID].Objects[objectName].Operations.Lookup(operation)
+}
+
 // ModuleRegistry returns the list of API modules from static schema.
 func (r *Metadata) ModuleRegistry() common.Modules {
 	result := make(common.Modules, len(r.Modules))
@@ -37,5 +37,6 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		getNextRecords,
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
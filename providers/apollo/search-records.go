@@ -0,0 +1,76 @@
+package apollo
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// SearchRecords implements connectors.SearchConnector for objects read via POST search.
+// Apollo accepts named search parameters rather than expressions, ex: "q_keywords", "person_titles".
+// Therefore, filter must be AND of "eq" or "in" conditions, each becoming a parameter of the request.
+// Results are sorted by a single field, ex: "contact_last_activity_date".
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if !slices.Contains(postSearchObjects, ObjectType(params.ObjectName)) {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
+	body, err := makeSearchBody(params)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.search(ctx, params.ObjectName, params.NextPage, params.Fields, body,
+		common.WithPreservedFieldCase(params.PreserveFieldCase))
+}
+
+func makeSearchBody(params common.SearchParams) (map[string]any, error) {
+	size, err := strconv.Atoi(pageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.PageSize != 0 {
+		size = params.PageSize
+	}
+
+	body := map[string]any{
+		perPage: size,
+	}
+
+	if !params.Filter.IsEmpty() {
+		conditions, ok := params.Filter.Conditions()
+		if !ok || params.Filter.Logic == common.SearchLogicOr {
+			return nil, fmt.Errorf("%w: only AND of conditions is accepted", common.ErrUnsupportedSearchFilter)
+		}
+
+		for _, condition := range conditions {
+			switch condition.Operator {
+			case common.SearchOperatorEquals:
+				body[condition.Field] = condition.Value
+			case common.SearchOperatorIn:
+				body[condition.Field] = condition.Values()
+			default:
+				return nil, common.UnsupportedSearchOperator(condition.Operator)
+			}
+		}
+	}
+
+	switch len(params.Sort) {
+	case 0:
+	case 1:
+		body["sort_by_field"] = params.Sort[0].Field
+		body["sort_ascending"] = !params.Sort[0].Descending
+	default:
+		return nil, fmt.Errorf("%w: results are sorted by one field", common.ErrUnsupportedSearchFilter)
+	}
+
+	return body, nil
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
 )
 
 // search uses POST method to read data.It has a display limit of 50,000 records.
@@ -12,7 +13,18 @@ import (
 // Using this as is may lead to that issue.
 func (c *Connector) Search(ctx context.Context, config common.ReadParams,
 ) (*common.ReadResult, error) {
-	url, err := c.getAPIURL(config.ObjectName, readOp)
+	// Add sorting & filtering criteria
+	// Currently the default values, are what we needed
+	// API sorts by the last activity or creation date timestamp.
+	// So need to change the param details here.
+	return c.search(ctx, config.ObjectName, config.NextPage, config.Fields, []byte{},
+		common.WithPreservedFieldCase(config.PreserveFieldCase))
+}
+
+func (c *Connector) search(ctx context.Context, objectName string, nextPage common.NextPageToken,
+	fields datautils.StringSet, body any, options ...common.ParseOption,
+) (*common.ReadResult, error) {
+	url, err := c.getAPIURL(objectName, readOp)
 	if err != nil {
 		return nil, err
 	}
@@ -21,25 +33,21 @@ func (c *Connector) Search(ctx context.Context, config common.ReadParams,
 	url.AddPath(searchingPath)
 
 	// Check if searching the next page
-	if len(config.NextPage) > 0 {
-		url.WithQueryParam("page", config.NextPage.String())
+	if len(nextPage) > 0 {
+		url.WithQueryParam("page", nextPage.String())
 	}
 
-	// Add sorting & filtering criteria
-	// Currently the default values, are what we needed
-	// API sorts by the last activity or creation date timestamp.
-	// So need to change the param details here.
-
-	json, err := c.Client.Post(ctx, url.String(), []byte{})
+	json, err := c.Client.Post(ctx, url.String(), body)
 	if err != nil {
 		return nil, err
 	}
 
 	return common.ParseResult(
 		json,
-		searchRecords(responseKey[config.ObjectName]),
+		searchRecords(responseKey[objectName]),
 		getNextRecords,
 		common.GetMarshaledData,
-		config.Fields,
+		fields,
+		options...,
 	)
 }
@@ -0,0 +1,64 @@
+package atlassian
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Capabilities describes objects readable via the connector module, and either Jira issue or Confluence writes.
+// Since is converted into absolute JQL or CQL time frame with a precision of minutes.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	capabilities := common.NewCapabilities()
+
+	if c.Module.ID == ModuleJira {
+		capabilities.
+			WithRead(common.ReadCapabilities{
+				Incremental: true,
+				Filter:      true,
+				Pagination:  common.PaginationCursor,
+				MaxPageSize: pageSize,
+			}, objectNameIssue, objectNameIssues, objectNameComments).
+			WithRead(common.ReadCapabilities{
+				Incremental: true,
+				Pagination:  common.PaginationCursor,
+			}, objectNameWorklogs).
+			WithRead(common.ReadCapabilities{
+				Pagination:  common.PaginationOffset,
+				MaxPageSize: pageSize,
+			}, objectNameProjects, objectNameUsers)
+	}
+
+	if c.Module.ID == ModuleJiraAgile {
+		capabilities.
+			WithRead(common.ReadCapabilities{
+				Pagination:  common.PaginationOffset,
+				MaxPageSize: pageSize,
+			}, objectNameBoards).
+			WithRead(common.ReadCapabilities{
+				Pagination:  common.PaginationCursor,
+				MaxPageSize: pageSize,
+			}, objectNameSprints)
+	}
+
+	if c.Module.ID == ModuleConfluence {
+		return capabilities.
+			WithRead(common.ReadCapabilities{
+				Incremental: true,
+				Pagination:  common.PaginationCursor,
+				MaxPageSize: confluencePageSize,
+			}, objectNamePages, objectNameBlogPosts).
+			WithRead(common.ReadCapabilities{
+				Pagination:  common.PaginationCursor,
+				MaxPageSize: confluencePageSize,
+			}, objectNameSpaces, objectNameFooterComments, objectNameInlineComments, objectNameAttachments).
+			WithOperation(common.OperationCreate, supportedObjectsByConfluenceCreate.List()...).
+			WithOperation(common.OperationUpdate, supportedObjectsByConfluenceUpdate.List()...).
+			WithOperation(common.OperationDelete, supportedObjectsByConfluenceDelete.List()...), nil
+	}
+
+	return capabilities.
+		WithOperation(common.OperationCreate, objectNameIssue).
+		WithOperation(common.OperationUpdate, objectNameIssue).
+		WithOperation(common.OperationDelete, objectNameIssue), nil
+}
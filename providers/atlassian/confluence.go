@@ -0,0 +1,357 @@
+package atlassian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/spyzhov/ajson"
+)
+
+// BodyFormat is the representation of Confluence content body.
+// https://developer.atlassian.com/cloud/confluence/rest/v2/intro/#body-format
+type BodyFormat string
+
+const (
+	// BodyFormatStorage is the XHTML based format pages are stored in.
+	BodyFormatStorage BodyFormat = "storage"
+	// BodyFormatAtlasDocFormat is the JSON document format used by the Atlassian editor.
+	BodyFormatAtlasDocFormat BodyFormat = "atlas_doc_format"
+)
+
+var ErrUnsupportedBodyFormat = errors.New("body format is not supported")
+
+func (f BodyFormat) validate() error {
+	switch f {
+	case "", BodyFormatStorage, BodyFormatAtlasDocFormat:
+		return nil
+	default:
+		return fmt.Errorf("%w: %q", ErrUnsupportedBodyFormat, f)
+	}
+}
+
+const (
+	objectNameSpaces         = "spaces"
+	objectNamePages          = "pages"
+	objectNameBlogPosts      = "blogposts"
+	objectNameFooterComments = "footer-comments"
+	objectNameInlineComments = "inline-comments"
+	objectNameAttachments    = "attachments"
+)
+
+const (
+	// confluencePageSize is the largest number of records Confluence v2 lists return.
+	confluencePageSize = 250
+
+	// confluenceLegacyAPIPath hosts API v1, which is the only one supporting CQL search.
+	confluenceLegacyAPIPath = "wiki/rest/api"
+)
+
+var (
+	// confluenceBodyObjects accept body-format query parameter.
+	confluenceBodyObjects = datautils.NewSet( // nolint:gochecknoglobals
+		objectNamePages,
+		objectNameBlogPosts,
+		objectNameFooterComments,
+		objectNameInlineComments,
+	)
+
+	// confluenceContentTypes maps objects to the CQL type, these are the objects supporting Since.
+	confluenceContentTypes = datautils.Map[string, string]{ // nolint:gochecknoglobals
+		objectNamePages:     "page",
+		objectNameBlogPosts: "blogpost",
+	}
+
+	// Spaces can only be created, attachments are uploaded as files which is out of scope.
+	supportedObjectsByConfluenceCreate = datautils.NewSet( // nolint:gochecknoglobals
+		objectNameSpaces,
+		objectNamePages,
+		objectNameBlogPosts,
+		objectNameFooterComments,
+		objectNameInlineComments,
+	)
+	supportedObjectsByConfluenceUpdate = datautils.NewSet( // nolint:gochecknoglobals
+		objectNamePages,
+		objectNameBlogPosts,
+		objectNameFooterComments,
+		objectNameInlineComments,
+	)
+	supportedObjectsByConfluenceDelete = datautils.NewSet( // nolint:gochecknoglobals
+		objectNamePages,
+		objectNameBlogPosts,
+		objectNameFooterComments,
+		objectNameInlineComments,
+		objectNameAttachments,
+	)
+)
+
+// readConfluence lists Confluence objects paginated by cursor.
+// Pages and blog posts modified since the given time are found via CQL search first.
+// https://developer.atlassian.com/cloud/confluence/rest/v2/api-group-page/#api-pages-get
+func (c *Connector) readConfluence(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
+	if contentType, ok := confluenceContentTypes[config.ObjectName]; ok && !config.Since.IsZero() {
+		return c.readConfluenceSince(ctx, config, contentType)
+	}
+
+	url, err := c.buildConfluenceListURL(config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("limit", strconv.Itoa(confluencePageSize))
+
+	if len(config.NextPage) != 0 {
+		url.WithQueryParam("cursor", config.NextPage.String())
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		common.GetRecordsUnderJSONPath("results"),
+		getNextConfluenceCursor,
+		common.GetMarshaledData,
+		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
+	)
+}
+
+// readConfluenceSince searches content modified since the given time, then lists it by identifiers.
+// Search cursor is used as NextPage.
+// https://developer.atlassian.com/cloud/confluence/rest/v1/api-group-content/#api-wiki-rest-api-content-search-get
+func (c *Connector) readConfluenceSince(
+	ctx context.Context, config common.ReadParams, contentType string,
+) (*common.ReadResult, error) {
+	cql, err := c.buildCQL(ctx, contentType, config.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	searchURL, err := c.getProductURL(productConfluence, confluenceLegacyAPIPath, "content/search")
+	if err != nil {
+		return nil, err
+	}
+
+	searchURL.WithQueryParam("cql", cql)
+	searchURL.WithQueryParam("limit", strconv.Itoa(confluencePageSize))
+
+	if len(config.NextPage) != 0 {
+		searchURL.WithQueryParam("cursor", config.NextPage.String())
+	}
+
+	rsp, err := c.Client.Get(ctx, searchURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	body, ok := rsp.Body()
+	if !ok {
+		return nil, common.ErrEmptyJSONHTTPResponse
+	}
+
+	identifiers, err := getConfluenceSearchIdentifiers(body)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPage, err := getNextConfluenceCursor(body)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(identifiers) == 0 {
+		return &common.ReadResult{
+			Data:     []common.ReadResultRow{},
+			NextPage: common.NextPageToken(nextPage),
+			Done:     len(nextPage) == 0,
+		}, nil
+	}
+
+	url, err := c.buildConfluenceListURL(config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParamList("id", identifiers)
+	url.WithQueryParam("limit", strconv.Itoa(len(identifiers)))
+
+	rsp, err = c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		common.GetRecordsUnderJSONPath("results"),
+		func(*ajson.Node) (string, error) { return nextPage, nil },
+		common.GetMarshaledData,
+		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
+	)
+}
+
+func (c *Connector) buildConfluenceListURL(objectName string) (*urlbuilder.URL, error) {
+	url, err := c.getModuleURL(ModuleConfluence, objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(c.bodyFormat) != 0 && confluenceBodyObjects.Has(objectName) {
+		url.WithQueryParam("body-format", string(c.bodyFormat))
+	}
+
+	return url, nil
+}
+
+// buildCQL selects content of the type modified at or after the given moment.
+// CQL dates share the format and time zone semantics of JQL.
+// https://developer.atlassian.com/cloud/confluence/cql-fields/#last-modified
+func (c *Connector) buildCQL(ctx context.Context, contentType string, since time.Time) (string, error) {
+	location, err := c.getUserLocation(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	formatted := since.In(location).Truncate(time.Minute).Format(jqlTimeLayout)
+
+	return fmt.Sprintf(`type = %v AND lastmodified >= "%v" ORDER BY lastmodified`, contentType, formatted), nil
+}
+
+func getConfluenceSearchIdentifiers(node *ajson.Node) ([]string, error) {
+	results, err := jsonquery.New(node).Array("results", false)
+	if err != nil {
+		return nil, err
+	}
+
+	identifiers := make([]string, 0, len(results))
+
+	for _, result := range results {
+		identifier, err := jsonquery.New(result).Str("id", false)
+		if err != nil {
+			return nil, err
+		}
+
+		identifiers = append(identifiers, *identifier)
+	}
+
+	return identifiers, nil
+}
+
+// getNextConfluenceCursor extracts cursor from the link to the next page, ex:
+// "/wiki/api/v2/pages?cursor=eyJpZCI6IjEyMyJ9&limit=250".
+func getNextConfluenceCursor(node *ajson.Node) (string, error) {
+	next, err := jsonquery.New(node, "_links").StrWithDefault("next", "")
+	if err != nil {
+		return "", err
+	}
+
+	if len(next) == 0 {
+		return "", nil
+	}
+
+	link, err := url.Parse(next)
+	if err != nil {
+		return "", errors.Join(ErrInvalidNextPage, err)
+	}
+
+	return link.Query().Get("cursor"), nil
+}
+
+// writeConfluence creates or updates Confluence content.
+// Updates replace the content, payload must include the incremented version number, ex:
+//
+//	{"id": "123", "status": "current", "title": "Title", "version": {"number": 2}, "body": {...}}
+//
+// https://developer.atlassian.com/cloud/confluence/rest/v2/api-group-page/#api-pages-post
+// https://developer.atlassian.com/cloud/confluence/rest/v2/api-group-page/#api-pages-id-put
+func (c *Connector) writeConfluence(ctx context.Context, config common.WriteParams) (*common.WriteResult, error) {
+	supported := supportedObjectsByConfluenceCreate
+	if len(config.RecordId) != 0 {
+		supported = supportedObjectsByConfluenceUpdate
+	}
+
+	if !supported.Has(config.ObjectName) {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
+	url, err := c.getModuleURL(ModuleConfluence, config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var write common.WriteMethod = c.Client.Post
+	if len(config.RecordId) != 0 {
+		write = c.Client.Put
+
+		url.AddPath(config.RecordId)
+	}
+
+	rsp, err := write(ctx, url.String(), config.RecordData)
+	if err != nil {
+		return nil, err
+	}
+
+	body, ok := rsp.Body()
+	if !ok {
+		return &common.WriteResult{
+			Success:  true,
+			RecordId: config.RecordId,
+		}, nil
+	}
+
+	return constructConfluenceWriteResult(body)
+}
+
+// constructConfluenceWriteResult returns the written content, unlike Jira, Confluence responds with the payload.
+func constructConfluenceWriteResult(body *ajson.Node) (*common.WriteResult, error) {
+	recordID, err := jsonquery.New(body).Str("id", false)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := jsonquery.Convertor.ObjectToMap(body)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.WriteResult{
+		Success:  true,
+		RecordId: *recordID,
+		Errors:   nil,
+		Data:     data,
+	}, nil
+}
+
+// deleteConfluence moves content to the trash, attachments included.
+// https://developer.atlassian.com/cloud/confluence/rest/v2/api-group-page/#api-pages-id-delete
+func (c *Connector) deleteConfluence(ctx context.Context, config common.DeleteParams) (*common.DeleteResult, error) {
+	if !supportedObjectsByConfluenceDelete.Has(config.ObjectName) {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
+	url, err := c.getModuleURL(ModuleConfluence, config.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	url.AddPath(config.RecordId)
+
+	// 204 NoContent is expected
+	if _, err = c.Client.Delete(ctx, url.String()); err != nil {
+		return nil, err
+	}
+
+	return &common.DeleteResult{
+		Success: true,
+	}, nil
+}
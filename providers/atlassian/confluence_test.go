@@ -0,0 +1,324 @@
+package atlassian
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestReadConfluence(t *testing.T) { //nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responsePages := testutils.DataFromFile(t, "read-pages.json")
+
+	tests := []testroutines.Read{
+		{
+			Name:         "Jira objects are not available via Confluence module",
+			Input:        common.ReadParams{ObjectName: "issues", Fields: connectors.Fields("id")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name:  "Pages are listed with body and the cursor of the next page",
+			Input: common.ReadParams{ObjectName: "pages", Fields: connectors.Fields("title")},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/ex/confluence/ebc887b2-7e61-4059-ab35-71f15cc16e12/wiki/api/v2/pages"),
+					mockcond.QueryParam("body-format", "storage"),
+					mockcond.QueryParam("limit", "250"),
+				},
+				Then: mockserver.Response(http.StatusOK, responsePages),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"title": "Release notes"},
+				}, {
+					Fields: map[string]any{"title": "Onboarding"},
+				}},
+				NextPage: "eyJpZCI6IjY1ODEwIn0",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Spaces continue from the cursor without body format",
+			Input: common.ReadParams{
+				ObjectName: "spaces",
+				Fields:     connectors.Fields("key"),
+				NextPage:   "eyJpZCI6IjY1NTQwIn0",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/wiki/api/v2/spaces"),
+					mockcond.QueryParam("cursor", "eyJpZCI6IjY1NTQwIn0"),
+					mockcond.QueryParamsMissing("body-format"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"results": [{"id": "65541", "key": "AM", "name": "Ampersand", "type": "global"}],
+					"_links": {"base": "https://test-workspace.atlassian.net/wiki"}
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"key": "AM"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Pages modified since a moment are searched via CQL",
+			Input: common.ReadParams{
+				ObjectName: "pages",
+				Fields:     connectors.Fields("id", "title"),
+				Since:      time.Date(2024, 7, 22, 20, 0, 30, 0, time.UTC),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/wiki/rest/api/user/current"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"timeZone": "Europe/Berlin"}`),
+				}, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/wiki/rest/api/content/search"),
+						mockcond.QueryParam("cql",
+							`type = page AND lastmodified >= "2024/07/22 22:00" ORDER BY lastmodified`),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{
+						"results": [{"id": "65798", "type": "page"}, {"id": "65810", "type": "page"}],
+						"_links": {"next": "/rest/api/content/search?cql=type%3Dpage&cursor=raNDoMsTRiNg&limit=250"}
+					}`),
+				}, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/wiki/api/v2/pages"),
+						mockcond.QueryParam("id", "65798", "65810"),
+					},
+					Then: mockserver.Response(http.StatusOK, responsePages),
+				}},
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "65798", "title": "Release notes"},
+				}, {
+					Fields: map[string]any{"id": "65810", "title": "Onboarding"},
+				}},
+				NextPage: "raNDoMsTRiNg",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "No modified blog posts skip the content request",
+			Input: common.ReadParams{
+				ObjectName: "blogposts",
+				Fields:     connectors.Fields("id"),
+				Since:      time.Date(2024, 7, 22, 20, 0, 0, 0, time.UTC),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/wiki/rest/api/user/current"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"timeZone": "UTC"}`),
+				}, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/wiki/rest/api/content/search"),
+						mockcond.QueryParam("cql",
+							`type = blogpost AND lastmodified >= "2024/07/22 20:00" ORDER BY lastmodified`),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"results": [], "_links": {}}`),
+				}},
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 0,
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructConfluenceTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestWriteConfluence(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.Write{
+		{
+			Name:         "Spaces cannot be updated",
+			Input:        common.WriteParams{ObjectName: "spaces", RecordId: "65541", RecordData: "dummy"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name:         "Attachments cannot be created",
+			Input:        common.WriteParams{ObjectName: "attachments", RecordData: "dummy"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name:  "Page is created",
+			Input: common.WriteParams{ObjectName: "pages", RecordData: map[string]any{"title": "Release notes"}},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/wiki/api/v2/pages"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"id": "65798", "status": "current", "title": "Release notes", "version": {"number": 1}
+				}`),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.WriteResult) bool {
+				return mockutils.WriteResultComparator.SubsetData(actual, expected)
+			},
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "65798",
+				Data:     map[string]any{"title": "Release notes"},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Footer comment is updated",
+			Input: common.WriteParams{
+				ObjectName: "footer-comments",
+				RecordId:   "98311",
+				RecordData: map[string]any{"version": map[string]any{"number": 2}},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPUT(),
+					mockcond.PathSuffix("/wiki/api/v2/footer-comments/98311"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"id": "98311", "status": "current", "pageId": "65798", "version": {"number": 2}
+				}`),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.WriteResult) bool {
+				return mockutils.WriteResultComparator.SubsetData(actual, expected)
+			},
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "98311",
+				Data:     map[string]any{"pageId": "65798"},
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.WriteConnector, error) {
+				return constructConfluenceTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestDeleteConfluence(t *testing.T) {
+	t.Parallel()
+
+	tests := []testroutines.Delete{
+		{
+			Name:         "Spaces cannot be deleted",
+			Input:        common.DeleteParams{ObjectName: "spaces", RecordId: "65541"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name:  "Attachment is deleted",
+			Input: common.DeleteParams{ObjectName: "attachments", RecordId: "att65900"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodDELETE(),
+					mockcond.PathSuffix("/wiki/api/v2/attachments/att65900"),
+				},
+				Then: mockserver.Response(http.StatusNoContent),
+			}.Server(),
+			Expected:     &common.DeleteResult{Success: true},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.DeleteConnector, error) {
+				return constructConfluenceTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestBodyFormatValidation(t *testing.T) {
+	t.Parallel()
+
+	_, err := NewConnector(
+		WithAuthenticatedClient(http.DefaultClient),
+		WithWorkspace("test-workspace"),
+		WithModule(ModuleConfluence),
+		WithBodyFormat("view"),
+	)
+	if !errors.Is(err, ErrUnsupportedBodyFormat) {
+		t.Fatalf("expected connector to reject unsupported body format, got: %v", err)
+	}
+}
+
+func constructConfluenceTestConnector(serverURL string) (*Connector, error) {
+	connector, err := constructModuleTestConnector(serverURL, ModuleConfluence)
+	if err != nil {
+		return nil, err
+	}
+
+	connector.bodyFormat = BodyFormatStorage
+
+	return connector, nil
+}
@@ -3,6 +3,8 @@ package atlassian
 import (
 	"errors"
 	"fmt"
+	"sync"
+	"time"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/interpreter"
@@ -21,6 +23,13 @@ type Connector struct {
 	// workspace is used to find cloud ID.
 	workspace string
 	cloudId   string
+
+	// bodyFormat is the representation of Confluence page body returned by Read.
+	bodyFormat BodyFormat
+
+	// location is the time zone of Atlassian user, used to format JQL and CQL dates.
+	location      *time.Location
+	locationMutex sync.Mutex
 }
 
 func NewConnector(opts ...Option) (conn *Connector, outErr error) {
@@ -41,8 +50,9 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		Client: &common.JSONHTTPClient{
 			HTTPClient: httpClient,
 		},
-		workspace: params.Workspace.Name,
-		Module:    params.Module.Selection,
+		workspace:  params.Workspace.Name,
+		Module:     params.Module.Selection,
+		bodyFormat: params.bodyFormat,
 	}
 
 	// Convert metadata map to model.
@@ -75,12 +85,27 @@ func (c *Connector) String() string {
 // URL format follows structure applicable to Oauth2 Atlassian apps.
 // https://developer.atlassian.com/cloud/jira/platform/rest/v2/intro/#other-integrations
 func (c *Connector) getJiraRestApiURL(arg string) (*urlbuilder.URL, error) {
+	return c.getModuleURL(c.Module.ID, arg)
+}
+
+// getModuleURL returns URL of sibling Jira API, ex: Agile API is used for boards regardless of connector module.
+func (c *Connector) getModuleURL(moduleID common.ModuleID, arg string) (*urlbuilder.URL, error) {
+	product, ok := moduleProducts[moduleID]
+	if !ok {
+		product = productJira
+	}
+
+	return c.getProductURL(product, supportedModules[moduleID].Path(), arg)
+}
+
+// getProductURL returns URL of any API hosted by the product, ex: Confluence v1 search.
+func (c *Connector) getProductURL(product, apiPath, arg string) (*urlbuilder.URL, error) {
 	cloudId, err := c.getCloudId()
 	if err != nil {
 		return nil, err
 	}
 
-	return urlbuilder.New(c.BaseURL, "ex/jira", cloudId, c.Module.Path(), arg)
+	return urlbuilder.New(c.BaseURL, product, cloudId, apiPath, arg)
 }
 
 // URL allows to get list of sites associated with auth token.
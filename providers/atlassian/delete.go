@@ -6,12 +6,16 @@ import (
 	"github.com/amp-labs/connectors/common"
 )
 
-// Delete removes Jira issue.
+// Delete removes Jira issue or Confluence content, depending on the connector module.
 func (c *Connector) Delete(ctx context.Context, config common.DeleteParams) (*common.DeleteResult, error) {
 	if err := config.ValidateParams(); err != nil {
 		return nil, err
 	}
 
+	if c.Module.ID == ModuleConfluence {
+		return c.deleteConfluence(ctx, config)
+	}
+
 	url, err := c.getJiraRestApiURL("issue")
 	if err != nil {
 		return nil, err
@@ -0,0 +1,146 @@
+package atlassian
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+)
+
+var ErrUnknownTimeZone = errors.New("time zone of Atlassian user is unknown")
+
+// jqlTimeLayout is the most precise absolute time format accepted by JQL.
+// Values are interpreted in the time zone of the user making the request.
+// https://support.atlassian.com/jira-software-cloud/docs/jql-fields/#Updated
+const jqlTimeLayout = "2006/01/02 15:04"
+
+// JQL builds Jira Query Language expression from conditions joined by AND.
+type JQL struct {
+	conditions []string
+	location   *time.Location
+}
+
+// NewJQL creates a query, timestamps are formatted in the given location.
+// Location must match the time zone of the Jira user, otherwise time frames are shifted.
+func NewJQL(location *time.Location) *JQL {
+	if location == nil {
+		location = time.UTC
+	}
+
+	return &JQL{location: location}
+}
+
+// Where adds caller provided condition. ORDER BY clause must not be part of it.
+func (q *JQL) Where(condition string) *JQL {
+	condition = strings.TrimSpace(condition)
+	if len(condition) != 0 {
+		q.conditions = append(q.conditions, "("+condition+")")
+	}
+
+	return q
+}
+
+// UpdatedSince limits issues to those modified at or after the given moment.
+// JQL has a precision of minutes, the moment is rounded down, so no updates are missed.
+func (q *JQL) UpdatedSince(since time.Time) *JQL {
+	return q.timeCondition("updated", ">=", since)
+}
+
+// CreatedSince limits issues to those created at or after the given moment.
+func (q *JQL) CreatedSince(since time.Time) *JQL {
+	return q.timeCondition("created", ">=", since)
+}
+
+func (q *JQL) timeCondition(field, operator string, moment time.Time) *JQL {
+	formatted := moment.In(q.location).Truncate(time.Minute).Format(jqlTimeLayout)
+	q.conditions = append(q.conditions, fmt.Sprintf(`%v %v "%v"`, field, operator, formatted))
+
+	return q
+}
+
+func (q *JQL) String() string {
+	return strings.Join(q.conditions, " AND ")
+}
+
+// unboundedSince is used when neither time frame nor filter is given.
+// Search API rejects queries without any restriction.
+var unboundedSince = time.Unix(0, 0) // nolint:gochecknoglobals
+
+// buildJQL combines caller filter with the time frame.
+// User time zone is requested only when time frame is given.
+func (c *Connector) buildJQL(ctx context.Context, filter string, since time.Time) (string, error) {
+	if since.IsZero() {
+		query := NewJQL(time.UTC).Where(filter)
+		if len(query.conditions) == 0 {
+			query.CreatedSince(unboundedSince)
+		}
+
+		return query.String(), nil
+	}
+
+	location, err := c.getUserLocation(ctx)
+	if err != nil {
+		return "", err
+	}
+
+	return NewJQL(location).Where(filter).UpdatedSince(since).String(), nil
+}
+
+type myselfResponse struct {
+	TimeZone string `json:"timeZone"`
+}
+
+// getUserLocation returns the time zone JQL and CQL dates are interpreted in.
+// It is requested once per connector.
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-myself/#api-rest-api-3-myself-get
+// https://developer.atlassian.com/cloud/confluence/rest/v1/api-group-users/#api-wiki-rest-api-user-current-get
+func (c *Connector) getUserLocation(ctx context.Context) (*time.Location, error) {
+	c.locationMutex.Lock()
+	defer c.locationMutex.Unlock()
+
+	if c.location != nil {
+		return c.location, nil
+	}
+
+	var (
+		url *urlbuilder.URL
+		err error
+	)
+
+	if c.Module.ID == ModuleConfluence {
+		url, err = c.getProductURL(productConfluence, confluenceLegacyAPIPath, "user/current")
+	} else {
+		url, err = c.getModuleURL(ModuleJira, "myself")
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	myself, err := common.UnmarshalJSON[myselfResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	location := time.UTC
+
+	if len(myself.TimeZone) != 0 {
+		location, err = time.LoadLocation(myself.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrUnknownTimeZone, err)
+		}
+	}
+
+	c.location = location
+
+	return location, nil
+}
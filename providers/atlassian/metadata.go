@@ -9,9 +9,14 @@ import (
 
 // ListObjectMetadata lists builtin and custom fields.
 // Supports only Issue object. Therefore, objectNames argument is ignored.
+// Confluence module has no field catalogue, metadata is not available.
 // API Reference:
 // https://developer.atlassian.com/cloud/jira/platform/rest/v2/api-group-issue-fields/#api-rest-api-2-field-get
 func (c *Connector) ListObjectMetadata(ctx context.Context, _ []string) (*common.ListObjectMetadataResult, error) {
+	if c.Module.ID == ModuleConfluence {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
 	url, err := c.getJiraRestApiURL("field")
 	if err != nil {
 		return nil, err
@@ -9,6 +9,12 @@ const (
 	ModuleEmpty common.ModuleID = ""
 	// ModuleJira is the module used for listing Jira issues.
 	ModuleJira common.ModuleID = "jira"
+	// ModuleJiraAgile is the Jira Software API for boards and sprints.
+	// https://developer.atlassian.com/cloud/jira/software/rest/intro/
+	ModuleJiraAgile common.ModuleID = "jira-agile"
+	// ModuleConfluence is the Confluence REST API v2 for spaces, pages, blog posts, comments and attachments.
+	// https://developer.atlassian.com/cloud/confluence/rest/v2/intro/
+	ModuleConfluence common.ModuleID = "confluence"
 )
 
 // supportedModules represents currently working and supported modules within the Atlassian connector.
@@ -24,4 +30,24 @@ var supportedModules = common.Modules{ // nolint: gochecknoglobals
 		Label:   "rest/api",
 		Version: "3",
 	},
+	ModuleJiraAgile: {
+		ID:      ModuleJiraAgile,
+		Label:   "rest/agile",
+		Version: "1.0",
+	},
+	ModuleConfluence: {
+		ID:      ModuleConfluence,
+		Label:   "wiki/api",
+		Version: "v2",
+	},
+}
+
+// moduleProducts maps module to the Atlassian product, which prefixes API URLs.
+var moduleProducts = map[common.ModuleID]string{ // nolint: gochecknoglobals
+	ModuleConfluence: productConfluence,
 }
+
+const (
+	productJira       = "ex/jira"
+	productConfluence = "ex/confluence"
+)
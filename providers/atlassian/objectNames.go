@@ -0,0 +1,61 @@
+package atlassian
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+const (
+	// objectNameIssue is the object Write and Delete operate on.
+	objectNameIssue    = "issue"
+	objectNameIssues   = "issues"
+	objectNameProjects = "projects"
+	objectNameUsers    = "users"
+	objectNameComments = "comments"
+	objectNameWorklogs = "worklogs"
+	objectNameBoards   = "boards"
+	objectNameSprints  = "sprints"
+)
+
+// supportedObjectsByRead lists objects readable via each module.
+var supportedObjectsByRead = datautils.Map[common.ModuleID, datautils.StringSet]{ // nolint:gochecknoglobals
+	ModuleJira: datautils.NewSet(
+		objectNameIssue,
+		objectNameIssues,
+		objectNameProjects,
+		objectNameUsers,
+		objectNameComments,
+		objectNameWorklogs,
+	),
+	ModuleJiraAgile: datautils.NewSet(
+		objectNameBoards,
+		objectNameSprints,
+	),
+	ModuleConfluence: datautils.NewSet(
+		objectNameSpaces,
+		objectNamePages,
+		objectNameBlogPosts,
+		objectNameFooterComments,
+		objectNameInlineComments,
+		objectNameAttachments,
+	),
+}
+
+// offsetResource is a collection paginated by start index.
+type offsetResource struct {
+	module common.ModuleID
+	path   string
+	// recordsKey holds the array of records, empty when response is an array itself.
+	recordsKey string
+	// query parameters applied to every request.
+	query map[string]string
+}
+
+var offsetResources = datautils.Map[string, offsetResource]{ // nolint:gochecknoglobals
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-projects/#api-rest-api-3-project-search-get
+	objectNameProjects: {module: ModuleJira, path: "project/search", recordsKey: "values"},
+	// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-users/#api-rest-api-3-users-search-get
+	objectNameUsers: {module: ModuleJira, path: "users/search"},
+	// https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-get
+	objectNameBoards: {module: ModuleJiraAgile, path: "board", recordsKey: "values"},
+}
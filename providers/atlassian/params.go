@@ -19,6 +19,7 @@ type parameters struct {
 	paramsbuilder.Workspace
 	paramsbuilder.Module
 	paramsbuilder.Metadata
+	bodyFormat BodyFormat
 }
 
 func (p parameters) ValidateParams() error {
@@ -27,6 +28,7 @@ func (p parameters) ValidateParams() error {
 		p.Workspace.ValidateParams(),
 		// Metadata parameter is optional.
 		p.Module.ValidateParams(),
+		p.bodyFormat.validate(),
 	)
 }
 
@@ -63,3 +65,11 @@ func WithMetadata(metadata map[string]string) Option {
 		params.WithMetadata(metadata, nil)
 	}
 }
+
+// WithBodyFormat requests Confluence pages, blog posts and comments to include body in the given format.
+// Body is omitted by default.
+func WithBodyFormat(format BodyFormat) Option {
+	return func(params *parameters) {
+		params.bodyFormat = format
+	}
+}
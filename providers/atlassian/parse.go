@@ -2,7 +2,6 @@ package atlassian
 
 import (
 	"errors"
-	"strconv"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/jsonquery"
@@ -61,34 +60,3 @@ func getRecords(node *ajson.Node) ([]map[string]any, error) {
 
 	return list, nil
 }
-
-// Next starting page index is calculated base on current index and array size.
-func getNextRecords(node *ajson.Node) (string, error) {
-	records, err := getRecords(node)
-	if err != nil {
-		return "", err
-	}
-
-	size := int64(len(records))
-
-	if size == 0 {
-		// No elements returned for the current page.
-		// There is no need to go further, definitely we are at the end.
-		return "", nil
-	}
-
-	startAt, err := jsonquery.New(node).Integer("startAt", true)
-	if err != nil {
-		return "", err
-	}
-
-	if startAt == nil {
-		// we cannot determine the next page
-		return "", nil
-	}
-
-	// StartAt starts from zero
-	nextStartIndex := *startAt + size
-
-	return strconv.FormatInt(nextStartIndex, 10), nil
-}
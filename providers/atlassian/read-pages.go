@@ -0,0 +1,111 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/spyzhov/ajson"
+)
+
+// readOffsetPages reads collections paginated by start index. NextPage holds the index.
+func (c *Connector) readOffsetPages(
+	ctx context.Context, config common.ReadParams, resource offsetResource,
+) (*common.ReadResult, error) {
+	startAt, err := parseStartAt(config.NextPage)
+	if err != nil {
+		return nil, err
+	}
+
+	url, err := c.getModuleURL(resource.module, resource.path)
+	if err != nil {
+		return nil, err
+	}
+
+	for name, value := range resource.query {
+		url.WithQueryParam(name, value)
+	}
+
+	url.WithQueryParam("startAt", strconv.Itoa(startAt))
+	url.WithQueryParam("maxResults", strconv.Itoa(pageSize))
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		makeOffsetRecords(resource.recordsKey),
+		makeNextStartAt(resource.recordsKey, startAt),
+		common.GetMarshaledData,
+		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
+	)
+}
+
+func parseStartAt(token common.NextPageToken) (int, error) {
+	if len(token) == 0 {
+		return 0, nil
+	}
+
+	startAt, err := strconv.Atoi(token.String())
+	if err != nil {
+		return 0, fmt.Errorf("%w: %q", ErrInvalidNextPage, token)
+	}
+
+	return startAt, nil
+}
+
+func makeOffsetRecords(recordsKey string) common.RecordsFunc {
+	return func(node *ajson.Node) ([]map[string]any, error) {
+		arr, err := offsetRecordsArray(node, recordsKey)
+		if err != nil {
+			return nil, err
+		}
+
+		return jsonquery.Convertor.ArrayToMap(arr)
+	}
+}
+
+func offsetRecordsArray(node *ajson.Node, recordsKey string) ([]*ajson.Node, error) {
+	if len(recordsKey) == 0 {
+		return node.GetArray()
+	}
+
+	return jsonquery.New(node).Array(recordsKey, false)
+}
+
+// makeNextStartAt advances start index by the number of returned records.
+// Paginated objects report the last page, plain arrays end with an incomplete page.
+func makeNextStartAt(recordsKey string, startAt int) common.NextPageFunc {
+	return func(node *ajson.Node) (string, error) {
+		arr, err := offsetRecordsArray(node, recordsKey)
+		if err != nil {
+			return "", err
+		}
+
+		if len(arr) == 0 {
+			return "", nil
+		}
+
+		if len(recordsKey) == 0 {
+			if len(arr) < pageSize {
+				return "", nil
+			}
+		} else {
+			isLast, err := jsonquery.New(node).BoolWithDefault("isLast", false)
+			if err != nil {
+				return "", err
+			}
+
+			if isLast {
+				return "", nil
+			}
+		}
+
+		return strconv.Itoa(startAt + len(arr)), nil
+	}
+}
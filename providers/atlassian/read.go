@@ -2,24 +2,62 @@ package atlassian
 
 import (
 	"context"
-	"fmt"
+	"errors"
+	"strconv"
 	"time"
 
 	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
 	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/spyzhov/ajson"
 )
 
-// Read only returns a list of Jira Issues.
+// pageSize is the number of records requested per page.
+const pageSize = 50
+
+var ErrInvalidNextPage = errors.New("next page token is malformed")
+
+// Read returns records of Jira, Jira Agile or Confluence objects depending on the connector module.
 // You can provide the following values:
-// * ObjectName - ignored.
+// * ObjectName - issues, projects, users, comments, worklogs for Jira; boards, sprints for Jira Agile;
+// spaces, pages, blogposts, footer-comments, inline-comments, attachments for Confluence.
 // * NextPage - to get next page which may have no elements left.
-// * Since - to scope the time frame, precision is in minutes.
+// * Since - to scope the time frame of issues, comments, worklogs, Confluence pages and blog posts.
+// Issues and pages are compared using absolute time in the time zone of the user, precision is in minutes.
+// * Filter - JQL condition selecting issues, and comments of those issues.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	if err := config.ValidateParams(true); err != nil {
 		return nil, err
 	}
 
-	url, err := c.buildReadURL(config)
+	if !supportedObjectsByRead[c.Module.ID].Has(config.ObjectName) {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
+	if c.Module.ID == ModuleConfluence {
+		return c.readConfluence(ctx, config)
+	}
+
+	switch config.ObjectName {
+	case objectNameIssue, objectNameIssues:
+		return c.readIssues(ctx, config, getRecords)
+	case objectNameComments:
+		return c.readIssues(ctx, config, makeCommentRecords(config.Since), common.WithClientSideFilter())
+	case objectNameWorklogs:
+		return c.readWorklogs(ctx, config)
+	case objectNameSprints:
+		return c.readSprints(ctx, config)
+	default:
+		return c.readOffsetPages(ctx, config, offsetResources[config.ObjectName])
+	}
+}
+
+// readIssues uses enhanced search which paginates with token.
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-search/#api-rest-api-3-search-jql-get
+func (c *Connector) readIssues(
+	ctx context.Context, config common.ReadParams, records common.RecordsFunc, options ...common.ParseOption,
+) (*common.ReadResult, error) {
+	url, err := c.buildSearchURL(ctx, config)
 	if err != nil {
 		return nil, err
 	}
@@ -31,35 +69,123 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 
 	return common.ParseResult(
 		rsp,
-		getRecords,
-		getNextRecords,
+		records,
+		getNextPageToken,
 		common.GetMarshaledData,
 		config.Fields,
+		append(options, common.WithPreservedFieldCase(config.PreserveFieldCase))...,
 	)
 }
 
-func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, error) {
-	url, err := c.getJiraRestApiURL("search")
+func (c *Connector) buildSearchURL(ctx context.Context, config common.ReadParams) (*urlbuilder.URL, error) {
+	url, err := c.getModuleURL(ModuleJira, "search/jql")
 	if err != nil {
 		return nil, err
 	}
 
+	jql, err := c.buildJQL(ctx, config.Filter, config.Since)
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("jql", jql)
+	url.WithQueryParam("fields", "*all")
+	url.WithQueryParam("maxResults", strconv.Itoa(pageSize))
+
 	if len(config.NextPage) != 0 {
-		url.WithQueryParam("startAt", config.NextPage.String())
+		url.WithQueryParam("nextPageToken", config.NextPage.String())
 	}
 
-	if !config.Since.IsZero() {
-		// Read URL supports time scoping. common.ReadParams.Since is used to get relative time frame.
-		// Here is an API example on how to request issues that were updated in the last 30 minutes.
-		// search?jql=updated > "-30m"
-		// The reason we use minutes is that it is the most granular API permits.
-		diff := time.Since(config.Since)
+	return url, nil
+}
+
+// makeCommentRecords lists comments embedded in issues, each comment references its issue.
+// Issue may be updated for reasons other than comments, therefore comments are also scoped by time.
+func makeCommentRecords(since time.Time) common.RecordsFunc {
+	return func(node *ajson.Node) ([]map[string]any, error) {
+		issues, err := jsonquery.New(node).Array("issues", false)
+		if err != nil {
+			return nil, err
+		}
+
+		list := make([]map[string]any, 0)
 
-		minutes := int64(diff.Minutes())
-		if minutes > 0 {
-			url.WithQueryParam("jql", fmt.Sprintf(`updated > "-%vm"`, minutes))
+		for _, issue := range issues {
+			comments, err := parseIssueComments(issue, since)
+			if err != nil {
+				return nil, errors.Join(common.ErrParseError, err)
+			}
+
+			list = append(list, comments...)
 		}
+
+		return list, nil
 	}
+}
 
-	return url, nil
+func parseIssueComments(issue *ajson.Node, since time.Time) ([]map[string]any, error) {
+	issueID, err := jsonquery.New(issue).Str("id", false)
+	if err != nil {
+		return nil, err
+	}
+
+	issueKey, err := jsonquery.New(issue).StrWithDefault("key", "")
+	if err != nil {
+		return nil, err
+	}
+
+	arr, err := jsonquery.New(issue, "fields", "comment").Array("comments", true)
+	if err != nil {
+		return nil, err
+	}
+
+	comments, err := jsonquery.Convertor.ArrayToMap(arr)
+	if err != nil {
+		return nil, err
+	}
+
+	list := make([]map[string]any, 0, len(comments))
+
+	for _, comment := range comments {
+		if !since.IsZero() && updatedBefore(comment, since) {
+			continue
+		}
+
+		comment["issueId"] = *issueID
+		comment["issueKey"] = issueKey
+		list = append(list, comment)
+	}
+
+	return list, nil
+}
+
+// jiraTimeLayout is the format of timestamps returned by Jira.
+const jiraTimeLayout = "2006-01-02T15:04:05.000-0700"
+
+func updatedBefore(record map[string]any, since time.Time) bool {
+	updated, ok := record["updated"].(string)
+	if !ok {
+		return false
+	}
+
+	moment, err := time.Parse(jiraTimeLayout, updated)
+	if err != nil {
+		return false
+	}
+
+	return moment.Before(since)
+}
+
+// getNextPageToken returns token of the next page of enhanced search.
+func getNextPageToken(node *ajson.Node) (string, error) {
+	isLast, err := jsonquery.New(node).BoolWithDefault("isLast", false)
+	if err != nil {
+		return "", err
+	}
+
+	if isLast {
+		return "", nil
+	}
+
+	return jsonquery.New(node).StrWithDefault("nextPageToken", "")
 }
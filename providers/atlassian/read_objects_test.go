@@ -0,0 +1,376 @@
+package atlassian
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestReadJiraObjects(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
+	t.Parallel()
+
+	responseComments := testutils.DataFromFile(t, "read-comments.json")
+	responseProjects := testutils.DataFromFile(t, "read-projects.json")
+
+	tests := []testroutines.Read{
+		{
+			Name:         "Agile objects are not available via Jira module",
+			Input:        common.ReadParams{ObjectName: "sprints", Fields: connectors.Fields("id")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name:  "Projects are paginated by start index",
+			Input: common.ReadParams{ObjectName: "projects", Fields: connectors.Fields("key")},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/rest/api/3/project/search"),
+					mockcond.QueryParam("startAt", "0"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseProjects),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"key": "OPS"},
+				}, {
+					Fields: map[string]any{"key": "AM"},
+				}},
+				NextPage: "2",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:  "Users response is an array, incomplete page is the last",
+			Input: common.ReadParams{ObjectName: "users", Fields: connectors.Fields("displayName"), NextPage: "50"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/rest/api/3/users/search"),
+					mockcond.QueryParam("startAt", "50"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `[
+					{"accountId": "70121:05d32b6e", "accountType": "atlassian", "displayName": "Bob"}
+				]`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"displayname": "Bob"},
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Comments are taken from issues and scoped by time",
+			Input: common.ReadParams{
+				ObjectName: "comments",
+				Fields:     connectors.Fields("id", "issueKey"),
+				Since:      time.Date(2024, 7, 22, 20, 0, 0, 0, time.UTC),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/rest/api/3/myself"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"timeZone": "UTC"}`),
+				}, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/rest/api/3/search/jql"),
+						mockcond.QueryParam("jql", `updated >= "2024/07/22 20:00"`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseComments),
+				}},
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "10101", "issuekey": "AM-2"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Page without recent comments continues to the next page",
+			Input: common.ReadParams{
+				ObjectName: "comments",
+				Fields:     connectors.Fields("id"),
+				Since:      time.Date(2024, 7, 22, 20, 0, 0, 0, time.UTC),
+				NextPage:   "page-2",
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/rest/api/3/myself"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"timeZone": "UTC"}`),
+				}, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/rest/api/3/search/jql"),
+						mockcond.QueryParam("nextPageToken", "page-2"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"nextPageToken": "page-3", "issues": [{
+						"id": "10001", "key": "AM-2", "fields": {"comment": {"comments": [{
+							"id": "10100", "updated": "2024-07-20T10:00:00.000+0000"
+						}]}}
+					}]}`),
+				}},
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows:     0,
+				Data:     []common.ReadResultRow{},
+				NextPage: "page-3",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Worklogs updated since a moment are fetched by IDs",
+			Input: common.ReadParams{
+				ObjectName: "worklogs",
+				Fields:     connectors.Fields("id", "timeSpent"),
+				Since:      time.UnixMilli(1721678400000),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.PathSuffix("/rest/api/3/worklog/updated"),
+						mockcond.QueryParam("since", "1721678400000"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{
+						"values": [{"worklogId": 103, "updatedTime": 1721680000000, "properties": []}],
+						"since": 1721678400000,
+						"until": 1721680000000,
+						"lastPage": false
+					}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.PathSuffix("/rest/api/3/worklog/list"),
+						mockcond.Body(`{"ids":[103]}`),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `[
+						{"id": "103", "issueId": "10001", "timeSpent": "3h 20m", "timeSpentSeconds": 12000}
+					]`),
+				}},
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "103", "timespent": "3h 20m"},
+				}},
+				NextPage: "1721680000000",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:  "No updated worklogs skip the content request",
+			Input: common.ReadParams{ObjectName: "worklogs", Fields: connectors.Fields("id")},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/rest/api/3/worklog/updated"),
+					mockcond.QueryParam("since", "0"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"values": [], "since": 0, "until": 0, "lastPage": true
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 0,
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestReadJiraAgileObjects(t *testing.T) { //nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responseSprints := testutils.DataFromFile(t, "read-sprints.json")
+
+	tests := []testroutines.Read{
+		{
+			Name:         "Issues are not available via Jira Agile module",
+			Input:        common.ReadParams{ObjectName: "issues", Fields: connectors.Fields("id")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+		{
+			Name:  "Boards are read from Agile API",
+			Input: common.ReadParams{ObjectName: "boards", Fields: connectors.Fields("name")},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/rest/agile/1.0/board"),
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"maxResults": 50, "startAt": 0, "isLast": true,
+					"values": [{"id": 2, "name": "AM board", "type": "scrum"}]
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"name": "AM board"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Sprints of a board continue with the next board",
+			Input: common.ReadParams{
+				ObjectName: "sprints",
+				Fields:     connectors.Fields("name"),
+				NextPage:   "board=1&sprint=0",
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.PathSuffix("/rest/agile/1.0/board"),
+						mockcond.QueryParam("type", "scrum"),
+						mockcond.QueryParam("startAt", "1"),
+						mockcond.QueryParam("maxResults", "1"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{
+						"maxResults": 1, "startAt": 1, "isLast": false,
+						"values": [{"id": 2, "name": "AM board", "type": "scrum"}]
+					}`),
+				}, {
+					If:   mockcond.PathSuffix("/rest/agile/1.0/board/2/sprint"),
+					Then: mockserver.Response(http.StatusOK, responseSprints),
+				}},
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"name": "AM Sprint 1"},
+				}, {
+					Fields: map[string]any{"name": "AM Sprint 2"},
+				}},
+				NextPage: "board=2&sprint=0",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:         "Sprints cursor must be well formed",
+			Input:        common.ReadParams{ObjectName: "sprints", Fields: connectors.Fields("id"), NextPage: "17"},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrInvalidNextPage},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructModuleTestConnector(tt.Server.URL, ModuleJiraAgile)
+			})
+		})
+	}
+}
+
+func TestJQL(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	if err != nil {
+		t.Fatalf("failed to load location: %v", err)
+	}
+
+	since := time.Date(2024, 1, 15, 10, 30, 59, 0, time.UTC)
+
+	tests := []struct {
+		name     string
+		query    *JQL
+		expected string
+	}{
+		{
+			name:     "Time is formatted in the given location",
+			query:    NewJQL(berlin).UpdatedSince(since),
+			expected: `updated >= "2024/01/15 11:30"`,
+		},
+		{
+			name:     "Caller condition is wrapped in parentheses",
+			query:    NewJQL(nil).Where("project = AM OR assignee = currentUser()").UpdatedSince(since),
+			expected: `(project = AM OR assignee = currentUser()) AND updated >= "2024/01/15 10:30"`,
+		},
+		{
+			name:     "Blank condition is ignored",
+			query:    NewJQL(nil).Where("  "),
+			expected: ``,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			if actual := tt.query.String(); actual != tt.expected {
+				t.Fatalf("expected: (%v), got: (%v)", tt.expected, actual)
+			}
+		})
+	}
+}
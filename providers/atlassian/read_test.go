@@ -128,7 +128,7 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			ExpectedErrs: []error{jsonquery.ErrKeyNotFound},
 		},
 		{
-			Name:  "Missing starting index produces no next page",
+			Name:  "Missing next page token produces no next page",
 			Input: common.ReadParams{ObjectName: "issues", Fields: connectors.Fields("id")},
 			Server: mockserver.Fixed{
 				Setup: mockserver.ContentJSON(),
@@ -150,13 +150,14 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			ExpectedErrs: nil,
 		},
 		{
-			Name:  "Next page is implied from start index and issues size",
+			Name:  "Next page token is returned until the last page",
 			Input: common.ReadParams{ObjectName: "issues", Fields: connectors.Fields("id")},
 			Server: mockserver.Fixed{
 				Setup: mockserver.ContentJSON(),
 				Always: mockserver.ResponseString(http.StatusOK, `
 				{
-				  "startAt": 6,
+				  "nextPageToken": "CAEaAggD",
+				  "isLast": false,
 				  "issues": [
 					{"fields":{}, "id": "0"},
 					{"fields":{}, "id": "1"}
@@ -167,27 +168,56 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			Expected: &common.ReadResult{
 				Rows:     2,
-				NextPage: "8",
+				NextPage: "CAEaAggD",
 				Done:     false,
 			},
 			ExpectedErrs: nil,
 		},
 		{
-			Name: "Since rounds to minute time frame",
+			Name:  "Last page ignores next page token",
+			Input: common.ReadParams{ObjectName: "issues", Fields: connectors.Fields("id")},
+			Server: mockserver.Fixed{
+				Setup: mockserver.ContentJSON(),
+				Always: mockserver.ResponseString(http.StatusOK, `
+				{
+				  "nextPageToken": "CAEaAggD",
+				  "isLast": true,
+				  "issues": [{"fields":{}, "id": "0"}]
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return nextPageComparator(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows:     1,
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Since is absolute time in user time zone rounded down to minute",
 			Input: common.ReadParams{
 				ObjectName: "issues",
 				Fields:     connectors.Fields("id"),
-				Since:      time.Now().Add(-5 * time.Minute),
+				Since:      time.Date(2024, 7, 22, 20, 0, 30, 0, time.UTC),
 			},
-			Server: mockserver.Conditional{
+			Server: mockserver.Switch{
 				Setup: mockserver.ContentJSON(),
-				// server was asked to get issues that occurred in the last 5 min
-				If: mockcond.QueryParam("jql", `updated > "-5m"`),
-				Then: mockserver.ResponseString(http.StatusOK, `
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/rest/api/3/myself"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"timeZone": "America/Vancouver"}`),
+				}, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/rest/api/3/search/jql"),
+						mockcond.QueryParam("jql", `updated >= "2024/07/22 13:00"`),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `
 					{
-					  "startAt": 0,
+					  "isLast": true,
 					  "issues": [{"fields":{}, "id": "0"}]
 					}`),
+				}},
 			}.Server(),
 			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
 				return actual.Rows == expected.Rows
@@ -197,19 +227,50 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil, // there must be no errors.
 		},
+		{
+			Name: "Filter is combined with time frame",
+			Input: common.ReadParams{
+				ObjectName: "issues",
+				Fields:     connectors.Fields("id"),
+				Filter:     "project = AM OR project = OPS",
+				Since:      time.Date(2024, 7, 22, 20, 0, 30, 0, time.UTC),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/rest/api/3/myself"),
+					Then: mockserver.ResponseString(http.StatusOK, `{"timeZone": "UTC"}`),
+				}, {
+					If: mockcond.QueryParam("jql",
+						`(project = AM OR project = OPS) AND updated >= "2024/07/22 20:00"`),
+					Then: mockserver.ResponseString(http.StatusOK, `
+					{
+					  "isLast": true,
+					  "issues": [{"fields":{}, "id": "0"}]
+					}`),
+				}},
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return actual.Rows == expected.Rows
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+			},
+			ExpectedErrs: nil,
+		},
 		{
 			Name: "Next page is propagated in query params",
 			Input: common.ReadParams{
 				ObjectName: "issues",
 				Fields:     connectors.Fields("id"),
-				NextPage:   "17",
+				NextPage:   "CAEaAggD",
 			},
 			Server: mockserver.Conditional{
 				Setup: mockserver.ContentJSON(),
-				If:    mockcond.QueryParam("startAt", "17"),
+				If:    mockcond.QueryParam("nextPageToken", "CAEaAggD"),
 				Then: mockserver.ResponseString(http.StatusOK, `
 					{
-					  "startAt": 17,
+					  "isLast": true,
 					  "issues": [
 						{"fields":{}, "id": "0"},
 						{"fields":{}, "id": "1"},
@@ -266,7 +327,7 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 						"statuscategorychangedate": "2024-07-22T22:41:35.326+0300",
 					},
 				}},
-				NextPage: "2",
+				NextPage: "CAEaAggD",
 				Done:     false,
 			},
 			ExpectedErrs: nil,
@@ -314,10 +375,14 @@ func nextPageComparator(actual *common.ReadResult, expected *common.ReadResult)
 }
 
 func constructTestConnector(serverURL string) (*Connector, error) {
+	return constructModuleTestConnector(serverURL, ModuleJira)
+}
+
+func constructModuleTestConnector(serverURL string, module common.ModuleID) (*Connector, error) {
 	connector, err := NewConnector(
 		WithAuthenticatedClient(http.DefaultClient),
 		WithWorkspace("test-workspace"),
-		WithModule(ModuleJira),
+		WithModule(module),
 		WithMetadata(map[string]string{
 			"cloudId": "ebc887b2-7e61-4059-ab35-71f15cc16e12", // any value will work for the test
 		}),
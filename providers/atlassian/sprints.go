@@ -0,0 +1,160 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/spyzhov/ajson"
+)
+
+// sprintsCursor points at the board and the sprint index within it.
+// Encoded as "board=3&sprint=50".
+type sprintsCursor struct {
+	Board  int
+	Sprint int
+}
+
+func parseSprintsCursor(token common.NextPageToken) (*sprintsCursor, error) {
+	if len(token) == 0 {
+		return &sprintsCursor{}, nil
+	}
+
+	values, err := url.ParseQuery(token.String())
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidNextPage, token)
+	}
+
+	board, errBoard := strconv.Atoi(values.Get("board"))
+	sprint, errSprint := strconv.Atoi(values.Get("sprint"))
+
+	if errBoard != nil || errSprint != nil {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidNextPage, token)
+	}
+
+	return &sprintsCursor{Board: board, Sprint: sprint}, nil
+}
+
+func (s sprintsCursor) token() string {
+	return url.Values{
+		"board":  []string{strconv.Itoa(s.Board)},
+		"sprint": []string{strconv.Itoa(s.Sprint)},
+	}.Encode()
+}
+
+type boardsResponse struct {
+	Values []struct {
+		ID int64 `json:"id"`
+	} `json:"values"`
+	IsLast bool `json:"isLast"`
+}
+
+// readSprints iterates over scrum boards reading sprints of one board at a time.
+// Sprint is returned only for the board it was created on, therefore there are no duplicates.
+// https://developer.atlassian.com/cloud/jira/software/rest/api-group-board/#api-rest-agile-1-0-board-boardid-sprint-get
+func (c *Connector) readSprints(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
+	cursor, err := parseSprintsCursor(config.NextPage)
+	if err != nil {
+		return nil, err
+	}
+
+	boardsURL, err := c.getModuleURL(ModuleJiraAgile, "board")
+	if err != nil {
+		return nil, err
+	}
+
+	// Kanban boards have no sprints.
+	boardsURL.WithQueryParam("type", "scrum")
+	boardsURL.WithQueryParam("startAt", strconv.Itoa(cursor.Board))
+	boardsURL.WithQueryParam("maxResults", "1")
+
+	rsp, err := c.Client.Get(ctx, boardsURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	boards, err := common.UnmarshalJSON[boardsResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(boards.Values) == 0 {
+		return &common.ReadResult{
+			Rows: 0,
+			Data: []common.ReadResultRow{},
+			Done: true,
+		}, nil
+	}
+
+	boardID := boards.Values[0].ID
+
+	sprintsURL, err := c.getModuleURL(ModuleJiraAgile, "board/"+strconv.FormatInt(boardID, 10)+"/sprint")
+	if err != nil {
+		return nil, err
+	}
+
+	sprintsURL.WithQueryParam("startAt", strconv.Itoa(cursor.Sprint))
+	sprintsURL.WithQueryParam("maxResults", strconv.Itoa(pageSize))
+
+	rsp, err = c.Client.Get(ctx, sprintsURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		makeSprintRecords(boardID),
+		makeNextSprintsCursor(*cursor, boards.IsLast),
+		common.GetMarshaledData,
+		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
+	)
+}
+
+func makeSprintRecords(boardID int64) common.RecordsFunc {
+	return func(node *ajson.Node) ([]map[string]any, error) {
+		records, err := makeOffsetRecords("values")(node)
+		if err != nil {
+			return nil, err
+		}
+
+		list := make([]map[string]any, 0, len(records))
+
+		for _, record := range records {
+			if origin, ok := record["originBoardId"].(float64); ok && int64(origin) != boardID {
+				continue
+			}
+
+			list = append(list, record)
+		}
+
+		return list, nil
+	}
+}
+
+func makeNextSprintsCursor(cursor sprintsCursor, lastBoard bool) common.NextPageFunc {
+	return func(node *ajson.Node) (string, error) {
+		size, err := jsonquery.New(node).ArraySize("values")
+		if err != nil {
+			return "", err
+		}
+
+		isLast, err := jsonquery.New(node).BoolWithDefault("isLast", true)
+		if err != nil {
+			return "", err
+		}
+
+		if !isLast && size != 0 {
+			return sprintsCursor{Board: cursor.Board, Sprint: cursor.Sprint + int(size)}.token(), nil
+		}
+
+		if lastBoard {
+			return "", nil
+		}
+
+		return sprintsCursor{Board: cursor.Board + 1}.token(), nil
+	}
+}
@@ -0,0 +1,96 @@
+package atlassian
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/spyzhov/ajson"
+)
+
+type updatedWorklogsResponse struct {
+	Values []struct {
+		WorklogID int64 `json:"worklogId"`
+	} `json:"values"`
+	Until    int64 `json:"until"`
+	LastPage bool  `json:"lastPage"`
+}
+
+type worklogListRequest struct {
+	IDs []int64 `json:"ids"`
+}
+
+// readWorklogs finds worklogs updated since a moment and then fetches their content.
+// NextPage holds the UNIX time in milliseconds to continue from.
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-worklog-updated-get
+// https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issue-worklogs/#api-rest-api-3-worklog-list-post
+func (c *Connector) readWorklogs(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
+	since := "0"
+
+	switch {
+	case len(config.NextPage) != 0:
+		if _, err := strconv.ParseInt(config.NextPage.String(), 10, 64); err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidNextPage, config.NextPage)
+		}
+
+		since = config.NextPage.String()
+	case !config.Since.IsZero():
+		since = strconv.FormatInt(config.Since.UnixMilli(), 10)
+	}
+
+	url, err := c.getModuleURL(ModuleJira, "worklog/updated")
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("since", since)
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	updated, err := common.UnmarshalJSON[updatedWorklogsResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	nextPage := ""
+	if !updated.LastPage {
+		nextPage = strconv.FormatInt(updated.Until, 10)
+	}
+
+	if len(updated.Values) == 0 {
+		return &common.ReadResult{
+			Rows:     0,
+			Data:     []common.ReadResultRow{},
+			NextPage: common.NextPageToken(nextPage),
+			Done:     len(nextPage) == 0,
+		}, nil
+	}
+
+	ids := make([]int64, len(updated.Values))
+	for index, value := range updated.Values {
+		ids[index] = value.WorklogID
+	}
+
+	listURL, err := c.getModuleURL(ModuleJira, "worklog/list")
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err = c.Client.Post(ctx, listURL.String(), worklogListRequest{IDs: ids})
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		makeOffsetRecords(""),
+		func(*ajson.Node) (string, error) { return nextPage, nil },
+		common.GetMarshaledData,
+		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
+	)
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/spyzhov/ajson"
 )
 
-// Write will either create or update a Jira issue.
+// Write will either create or update a Jira issue or Confluence content, depending on the connector module.
 // Create issue docs:
 // https://developer.atlassian.com/cloud/jira/platform/rest/v3/api-group-issues/#api-rest-api-3-issue-post
 // Update issue docs:
@@ -18,6 +18,10 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 		return nil, err
 	}
 
+	if c.Module.ID == ModuleConfluence {
+		return c.writeConfluence(ctx, config)
+	}
+
 	url, err := c.getJiraRestApiURL("issue")
 	if err != nil {
 		return nil, err
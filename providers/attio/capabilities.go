@@ -0,0 +1,30 @@
+package attio
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Capabilities describes supported objects. Attio has no time filtering, every read is a full read.
+// Only some objects are paginated, others are returned in a single response.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	capabilities := common.NewCapabilities()
+
+	for _, objectName := range supportedObjectsByRead.List() {
+		features := common.ReadCapabilities{
+			Pagination: common.PaginationNone,
+		}
+
+		if supportLimitAndOffset.Has(objectName) {
+			features.Pagination = common.PaginationOffset
+			features.MaxPageSize = DefaultPageSize
+		}
+
+		capabilities.WithRead(features, objectName)
+	}
+
+	return capabilities.
+		WithOperation(common.OperationCreate, supportedObjectsByWrite.List()...).
+		WithOperation(common.OperationUpdate, supportedObjectsByWrite.List()...), nil
+}
@@ -14,6 +14,9 @@ const (
 type Connector struct {
 	BaseURL string
 	Client  *common.JSONHTTPClient
+
+	// matchingAttributes are unique attributes used to assert records per object.
+	matchingAttributes map[string]string
 }
 
 func NewConnector(opts ...Option) (conn *Connector, outErr error) {
@@ -31,6 +34,7 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		Client: &common.JSONHTTPClient{
 			HTTPClient: params.Client.Caller,
 		},
+		matchingAttributes: params.matchingAttributes,
 	}
 
 	// Read provider info
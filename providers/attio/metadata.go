@@ -25,6 +25,19 @@ func (c *Connector) ListObjectMetadata(ctx context.Context,
 	}
 
 	for _, obj := range objectNames {
+		if target, ok := lookupRecordTarget(obj); ok {
+			metadata, err := c.describeRecords(ctx, obj, target)
+			if err != nil {
+				metadataResult.Errors[obj] = err
+
+				continue
+			}
+
+			metadataResult.Result[obj] = *metadata
+
+			continue
+		}
+
 		// Constructing the request url.
 		url, err := c.getApiURL(obj)
 		if err != nil {
@@ -14,6 +14,7 @@ type Option = func(params *parameters)
 
 type parameters struct {
 	paramsbuilder.Client
+	matchingAttributes map[string]string
 }
 
 const (
@@ -40,3 +41,15 @@ func WithAuthenticatedClient(client common.AuthenticatedHTTPClient) Option {
 		params.WithAuthenticatedClient(client)
 	}
 }
+
+// WithMatchingAttribute makes record creation for the object an assert, ex: "people" matched by "email_addresses".
+// When a record with the same attribute value exists it is updated instead of creating a duplicate.
+func WithMatchingAttribute(objectName, attribute string) Option {
+	return func(params *parameters) {
+		if params.matchingAttributes == nil {
+			params.matchingAttributes = make(map[string]string)
+		}
+
+		params.matchingAttributes[objectName] = attribute
+	}
+}
@@ -8,11 +8,18 @@ import (
 	"github.com/amp-labs/connectors/common/urlbuilder"
 )
 
+// Read returns workspace configuration objects or records.
+// Any object slug, ex: "people", "companies" or a custom object, reads its records,
+// while "lists/{list}/entries" reads entries of the list.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	if err := config.ValidateParams(true); err != nil {
 		return nil, err
 	}
 
+	if target, ok := lookupRecordTarget(config.ObjectName); ok {
+		return c.readRecords(ctx, config, target)
+	}
+
 	if !supportedObjectsByRead.Has(config.ObjectName) {
 		return nil, common.ErrOperationNotSupportedForObject
 	}
@@ -33,6 +40,7 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		makeNextRecordsURL(url),
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
 
@@ -36,7 +36,7 @@ func TestRead(t *testing.T) { // nolint:funlen,gocognit,cyclop
 		},
 		{
 			Name:         "Unknown objects are not supported",
-			Input:        common.ReadParams{ObjectName: "attributes", Fields: connectors.Fields("")},
+			Input:        common.ReadParams{ObjectName: "objects/people", Fields: connectors.Fields("")},
 			Server:       mockserver.Dummy(),
 			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
 		},
@@ -0,0 +1,379 @@
+package attio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/spyzhov/ajson"
+)
+
+// Records of standard and custom objects are addressed by object slug, ex: "people", "companies", "deals".
+// Entries of a list are addressed as "lists/{list}/entries", where list is a slug or ID.
+// Docs: https://developers.attio.com/reference/post_v2-objects-object-records-query
+const (
+	listEntriesSuffix = "/entries"
+
+	// recordsPageSize is the number of records requested per query, Attio allows up to 500.
+	recordsPageSize = 500
+)
+
+var ErrInvalidNextPage = errors.New("next page must be a records offset")
+
+var (
+	objectSlugRegex = regexp.MustCompile(`^[a-z][a-z0-9_]*$`)                // nolint:gochecknoglobals
+	listEntryRegex  = regexp.MustCompile(`^lists/([a-zA-Z0-9_-]+)/entries$`) // nolint:gochecknoglobals
+)
+
+// recordTarget describes where records of the object live, either under an object or a list.
+type recordTarget struct {
+	// path is the API path of the collection, ex: "objects/people" or "lists/sales/entries".
+	path string
+	// attributesPath is the API path listing attributes, ex: "objects/people/attributes".
+	attributesPath string
+	isListEntry    bool
+}
+
+// lookupRecordTarget returns record target if the object name refers to records rather than workspace configuration.
+func lookupRecordTarget(objectName string) (*recordTarget, bool) {
+	if supportedObjectsByRead.Has(objectName) {
+		return nil, false
+	}
+
+	if match := listEntryRegex.FindStringSubmatch(objectName); match != nil {
+		return &recordTarget{
+			path:           objectNameLists + "/" + match[1] + listEntriesSuffix,
+			attributesPath: objectNameLists + "/" + match[1] + "/attributes",
+			isListEntry:    true,
+		}, true
+	}
+
+	if objectSlugRegex.MatchString(objectName) {
+		return &recordTarget{
+			path:           objectNameObjects + "/" + objectName + "/records",
+			attributesPath: objectNameObjects + "/" + objectName + "/attributes",
+		}, true
+	}
+
+	return nil, false
+}
+
+type recordsQuery struct {
+	Limit  int `json:"limit"`
+	Offset int `json:"offset"`
+}
+
+// readRecords queries records or list entries. NextPage holds the offset of the next query.
+func (c *Connector) readRecords(
+	ctx context.Context, config common.ReadParams, target *recordTarget,
+) (*common.ReadResult, error) {
+	offset := 0
+
+	if len(config.NextPage) != 0 {
+		var err error
+
+		offset, err = strconv.Atoi(config.NextPage.String())
+		if err != nil {
+			return nil, fmt.Errorf("%w: %q", ErrInvalidNextPage, config.NextPage)
+		}
+	}
+
+	url, err := c.getApiURL(target.path + "/query")
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Post(ctx, url.String(), recordsQuery{
+		Limit:  recordsPageSize,
+		Offset: offset,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	valuesKey := "values"
+	if target.isListEntry {
+		valuesKey = "entry_values"
+	}
+
+	return common.ParseResult(
+		rsp,
+		common.GetRecordsUnderJSONPath("data"),
+		makeNextRecordsOffset(offset),
+		getFlattenedRecords(valuesKey),
+		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
+	)
+}
+
+func makeNextRecordsOffset(offset int) common.NextPageFunc {
+	return func(node *ajson.Node) (string, error) {
+		size, err := jsonquery.New(node).ArraySize("data")
+		if err != nil {
+			return "", err
+		}
+
+		if size < recordsPageSize {
+			return "", nil
+		}
+
+		return strconv.Itoa(offset + int(size)), nil
+	}
+}
+
+// getFlattenedRecords returns rows where Fields hold attribute values in a flat form,
+// while Raw keeps the original record with the history of each value.
+func getFlattenedRecords(valuesKey string) func([]map[string]any, []string) ([]common.ReadResultRow, error) {
+	return func(records []map[string]any, fields []string) ([]common.ReadResultRow, error) {
+		data := make([]common.ReadResultRow, len(records))
+
+		for index, record := range records {
+			data[index] = common.ReadResultRow{
+				Fields: common.ExtractLowercaseFieldsFromRaw(fields, flattenRecord(record, valuesKey)),
+				Raw:    record,
+			}
+		}
+
+		return data, nil
+	}
+}
+
+// flattenRecord merges identifiers, timestamps and attribute values into a single level map.
+// Every attribute holds a list of values, single valued attributes are reduced to a scalar.
+func flattenRecord(record map[string]any, valuesKey string) map[string]any {
+	result := make(map[string]any)
+
+	for key, value := range record {
+		if key != valuesKey && key != "id" {
+			result[key] = value
+		}
+	}
+
+	if identifiers, ok := record["id"].(map[string]any); ok {
+		for key, value := range identifiers {
+			result[key] = value
+		}
+
+		if recordID, ok := identifiers["record_id"]; ok {
+			result["id"] = recordID
+		} else if entryID, ok := identifiers["entry_id"]; ok {
+			result["id"] = entryID
+		}
+	}
+
+	attributes, _ := record[valuesKey].(map[string]any)
+	for slug, values := range attributes {
+		result[slug] = flattenAttributeValues(values)
+	}
+
+	return result
+}
+
+func flattenAttributeValues(values any) any {
+	list, ok := values.([]any)
+	if !ok {
+		return values
+	}
+
+	flat := make([]any, 0, len(list))
+
+	for _, item := range list {
+		if value, ok := item.(map[string]any); ok {
+			flat = append(flat, flattenAttributeValue(value))
+		} else {
+			flat = append(flat, item)
+		}
+	}
+
+	switch len(flat) {
+	case 0:
+		return nil
+	case 1:
+		return flat[0]
+	default:
+		return flat
+	}
+}
+
+// attributeValueKeys maps attribute type to the key holding the essence of the value.
+// Types that are not listed, ex: "location", are returned as objects without bookkeeping properties.
+var attributeValueKeys = map[string]string{ // nolint:gochecknoglobals
+	"currency":         "currency_value",
+	"record-reference": "target_record_id",
+	"actor-reference":  "referenced_actor_id",
+	"email-address":    "email_address",
+	"domain":           "domain",
+	"phone-number":     "phone_number",
+	"personal-name":    "full_name",
+}
+
+// attributeValueMetadata are bookkeeping properties present in every attribute value.
+var attributeValueMetadata = []string{ // nolint:gochecknoglobals
+	"active_from", "active_until", "created_by_actor", "attribute_type",
+}
+
+func flattenAttributeValue(item map[string]any) any {
+	attributeType, _ := item["attribute_type"].(string)
+
+	switch attributeType {
+	case "select":
+		return nestedTitle(item, "option")
+	case "status":
+		return nestedTitle(item, "status")
+	}
+
+	if key, ok := attributeValueKeys[attributeType]; ok {
+		return item[key]
+	}
+
+	if value, ok := item["value"]; ok {
+		return value
+	}
+
+	result := make(map[string]any)
+
+	for key, value := range item {
+		result[key] = value
+	}
+
+	for _, key := range attributeValueMetadata {
+		delete(result, key)
+	}
+
+	return result
+}
+
+func nestedTitle(item map[string]any, key string) any {
+	if nested, ok := item[key].(map[string]any); ok {
+		return nested["title"]
+	}
+
+	return nil
+}
+
+// writeRecord creates or updates a record. Record data holds attribute values, ex:
+//
+//	{"name": "Ampersand", "domains": ["withampersand.com"]}
+//
+// If connector has a matching attribute for the object new records are asserted,
+// updating an existing record with the same attribute value instead of failing on duplicates.
+func (c *Connector) writeRecord(
+	ctx context.Context, config common.WriteParams, target *recordTarget,
+) (*common.WriteResult, error) {
+	if target.isListEntry {
+		return nil, common.ErrOperationNotSupportedForObject
+	}
+
+	url, err := c.getApiURL(target.path)
+	if err != nil {
+		return nil, err
+	}
+
+	payload := map[string]any{
+		"data": map[string]any{
+			"values": config.RecordData,
+		},
+	}
+
+	var write common.WriteMethod
+
+	switch matchingAttribute, ok := c.matchingAttributes[config.ObjectName]; {
+	case len(config.RecordId) != 0:
+		write = c.Client.Patch
+
+		url.AddPath(config.RecordId)
+	case ok:
+		write = c.Client.Put
+
+		url.WithQueryParam("matching_attribute", matchingAttribute)
+	default:
+		write = c.Client.Post
+	}
+
+	rsp, err := write(ctx, url.String(), payload)
+	if err != nil {
+		return nil, err
+	}
+
+	body, ok := rsp.Body()
+	if !ok {
+		return &common.WriteResult{
+			Success:  true,
+			RecordId: config.RecordId,
+		}, nil
+	}
+
+	return constructRecordWriteResult(body)
+}
+
+func constructRecordWriteResult(body *ajson.Node) (*common.WriteResult, error) {
+	objectResponse, err := jsonquery.New(body).Object("data", false)
+	if err != nil {
+		return nil, err
+	}
+
+	recordID, err := jsonquery.New(objectResponse, "id").Str("record_id", false)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := jsonquery.Convertor.ObjectToMap(objectResponse)
+	if err != nil {
+		return nil, err
+	}
+
+	return &common.WriteResult{
+		Success:  true,
+		RecordId: *recordID,
+		Errors:   nil,
+		Data:     flattenRecord(response, "values"),
+	}, nil
+}
+
+type attributesResponse struct {
+	Data []attribute `json:"data"`
+}
+
+type attribute struct {
+	APISlug    string `json:"api_slug"`
+	Title      string `json:"title"`
+	IsArchived bool   `json:"is_archived"`
+}
+
+// describeRecords returns attributes of the object or list as fields.
+func (c *Connector) describeRecords(
+	ctx context.Context, objectName string, target *recordTarget,
+) (*common.ObjectMetadata, error) {
+	url, err := c.getApiURL(target.attributesPath)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := common.UnmarshalJSON[attributesResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	metadata := &common.ObjectMetadata{
+		DisplayName: objectName,
+		FieldsMap:   make(map[string]string),
+	}
+
+	for _, attr := range response.Data {
+		if !attr.IsArchived {
+			metadata.FieldsMap[strings.ToLower(attr.APISlug)] = attr.Title
+		}
+	}
+
+	return metadata, nil
+}
@@ -0,0 +1,305 @@
+package attio
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestReadRecords(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responsePeople := testutils.DataFromFile(t, "records-people.json")
+	responseEntries := testutils.DataFromFile(t, "list-entries.json")
+
+	tests := []testroutines.Read{
+		{
+			Name: "Records have flattened attribute values",
+			Input: common.ReadParams{
+				ObjectName: "people",
+				Fields:     connectors.Fields("id", "name", "email_addresses", "job_title", "company", "phone_numbers"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/v2/objects/people/records/query"),
+					mockcond.Body(`{"limit":500,"offset":0}`),
+				},
+				Then: mockserver.Response(http.StatusOK, responsePeople),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.NextPage.String() == expected.NextPage.String() &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"id":              "bf071e1f-6035-429d-b874-d83ea64ea13b",
+						"name":            "Ada Lovelace",
+						"email_addresses": []any{"ada@example.com", "ada@engine.org"},
+						"job_title":       "Mathematician",
+						"company":         "7f4e2c6d-9f52-4a46-b0b5-7ffb0b1b5a3e",
+						"phone_numbers":   nil,
+					},
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page is requested by offset",
+			Input: common.ReadParams{
+				ObjectName: "people",
+				Fields:     connectors.Fields("id"),
+				NextPage:   "500",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/v2/objects/people/records/query"),
+					mockcond.Body(`{"limit":500,"offset":500}`),
+				},
+				Then: mockserver.Response(http.StatusOK, responsePeople),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) && actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "bf071e1f-6035-429d-b874-d83ea64ea13b"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page must be an offset",
+			Input: common.ReadParams{
+				ObjectName: "people",
+				Fields:     connectors.Fields("id"),
+				NextPage:   "https://api.attio.com/v2/objects/people/records/query",
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrInvalidNextPage},
+		},
+		{
+			Name: "List entries have flattened entry values",
+			Input: common.ReadParams{
+				ObjectName: "lists/sales/entries",
+				Fields:     connectors.Fields("id", "parent_record_id", "stage", "deal_value"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/v2/lists/sales/entries/query"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseEntries),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"id":               "2e6e29ea-c4e0-4f44-842d-78a891f8c156",
+						"parent_record_id": "7f4e2c6d-9f52-4a46-b0b5-7ffb0b1b5a3e",
+						"stage":            "Qualified",
+						"deal_value":       float64(25000),
+					},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine.
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestWriteRecords(t *testing.T) { // nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responseRecord := testutils.DataFromFile(t, "write-record.json")
+
+	tests := []testroutines.Write{
+		{
+			Name: "Create record wraps attribute values",
+			Input: common.WriteParams{
+				ObjectName: "people",
+				RecordData: map[string]any{"job_title": "Mathematician"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/v2/objects/people/records"),
+					mockcond.Body(`{"data":{"values":{"job_title":"Mathematician"}}}`),
+				},
+				Then: mockserver.Response(http.StatusOK, responseRecord),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.WriteResult) bool {
+				return mockutils.WriteResultComparator.SubsetData(actual, expected) &&
+					actual.RecordId == expected.RecordId && actual.Success == expected.Success
+			},
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "bf071e1f-6035-429d-b874-d83ea64ea13b",
+				Data: map[string]any{
+					"id":        "bf071e1f-6035-429d-b874-d83ea64ea13b",
+					"job_title": "Mathematician",
+				},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Update record via PATCH",
+			Input: common.WriteParams{
+				ObjectName: "people",
+				RecordId:   "bf071e1f-6035-429d-b874-d83ea64ea13b",
+				RecordData: map[string]any{"job_title": "Mathematician"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPATCH(),
+					mockcond.PathSuffix("/v2/objects/people/records/bf071e1f-6035-429d-b874-d83ea64ea13b"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseRecord),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.WriteResult) bool {
+				return actual.RecordId == expected.RecordId && actual.Success == expected.Success
+			},
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "bf071e1f-6035-429d-b874-d83ea64ea13b",
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "List entries are read only",
+			Input: common.WriteParams{
+				ObjectName: "lists/sales/entries",
+				RecordData: map[string]any{"stage": "Qualified"},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine.
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.WriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestAssertRecord(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If: mockcond.And{
+			mockcond.MethodPUT(),
+			mockcond.PathSuffix("/v2/objects/people/records"),
+			mockcond.QueryParam("matching_attribute", "email_addresses"),
+		},
+		Then: mockserver.Response(http.StatusOK, testutils.DataFromFile(t, "write-record.json")),
+	}.Server()
+	defer server.Close()
+
+	connector, err := NewConnector(
+		WithAuthenticatedClient(http.DefaultClient),
+		WithMatchingAttribute("people", "email_addresses"),
+	)
+	if err != nil {
+		t.Fatalf("failed to create connector: %v", err)
+	}
+
+	connector.setBaseURL(server.URL)
+
+	result, err := connector.Write(context.Background(), common.WriteParams{
+		ObjectName: "people",
+		RecordData: map[string]any{"email_addresses": []string{"ada@example.com"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to assert record: %v", err)
+	}
+
+	if result.RecordId != "bf071e1f-6035-429d-b874-d83ea64ea13b" {
+		t.Fatalf("unexpected record id: %v", result.RecordId)
+	}
+}
+
+func TestListObjectMetadataRecords(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseAttributes := testutils.DataFromFile(t, "people-attributes.json")
+
+	tests := []testroutines.Metadata{
+		{
+			Name:  "Object attributes are fields, archived attributes are skipped",
+			Input: []string{"people"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/v2/objects/people/attributes"),
+				Then:  mockserver.Response(http.StatusOK, responseAttributes),
+			}.Server(),
+			Expected: &common.ListObjectMetadataResult{
+				Result: map[string]common.ObjectMetadata{
+					"people": {
+						DisplayName: "people",
+						FieldsMap: map[string]string{
+							"name":            "Name",
+							"email_addresses": "Email addresses",
+						},
+					},
+				},
+				Errors: map[string]error{},
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine.
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ObjectMetadataConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
@@ -19,6 +19,10 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 		return nil, err
 	}
 
+	if target, ok := lookupRecordTarget(config.ObjectName); ok {
+		return c.writeRecord(ctx, config, target)
+	}
+
 	if !supportedObjectsByWrite.Has(config.ObjectName) {
 		return nil, common.ErrOperationNotSupportedForObject
 	}
@@ -35,7 +35,7 @@ func TestWrite(t *testing.T) { // nolint:funlen,gocognit,cyclop
 		},
 		{
 			Name:     "Unknown object name is not supported",
-			Input:    common.WriteParams{ObjectName: "attributes", RecordData: "dummy"},
+			Input:    common.WriteParams{ObjectName: "workspace_members", RecordData: "dummy"},
 			Server:   mockserver.Dummy(),
 			Expected: nil,
 			ExpectedErrs: []error{
@@ -25,6 +25,8 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 			Fields:     config.Fields.List(),
 			Since:      config.Since,
 			NextPage:   config.NextPage,
+
+			PreserveFieldCase: config.PreserveFieldCase,
 		})
 	}
 
@@ -49,6 +51,7 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		nextRecordsURL(url),
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
 
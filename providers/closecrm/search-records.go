@@ -0,0 +1,183 @@
+package closecrm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// numberRangeKeys maps range operators to the keys of Close number range condition.
+var numberRangeKeys = map[common.SearchOperator]string{ // nolint:gochecknoglobals
+	common.SearchOperatorGreaterThan:        "gt",
+	common.SearchOperatorGreaterThanOrEqual: "gte",
+	common.SearchOperatorLessThan:           "lt",
+	common.SearchOperatorLessThanOrEqual:    "lte",
+}
+
+// momentRangeKeys maps range operators to the keys of Close moment range condition.
+// Moments are compared with inclusive lower bound and exclusive upper bound only.
+var momentRangeKeys = map[common.SearchOperator]string{ // nolint:gochecknoglobals
+	common.SearchOperatorGreaterThanOrEqual: OnOrAfterQueryKey,
+	common.SearchOperatorLessThan:           "before",
+}
+
+// SearchRecords implements connectors.SearchConnector using advanced filtering.
+// ObjectName is the Close object type, ex: "lead", "contact", "opportunity".
+// Fields are compared as regular fields of the object.
+//
+// doc: https://developer.close.com/resources/advanced-filtering
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	limit, err := strconv.Atoi(defaultPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	if params.PageSize != 0 {
+		limit = params.PageSize
+	}
+
+	queries := []map[string]any{{
+		TypeQueryKey:       "object_type",
+		ObjectTypeQueryKey: params.ObjectName,
+	}}
+
+	if !params.Filter.IsEmpty() {
+		query, err := makeSearchQuery(params.ObjectName, params.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		queries = append(queries, query)
+	}
+
+	filter := Filter{
+		Query: Query{
+			Type:    "and",
+			Queries: queries,
+		},
+		Fields: map[string][]string{
+			params.ObjectName: params.Fields.List(),
+		},
+		Limit: limit,
+	}
+
+	for _, sort := range params.Sort {
+		direction := "asc"
+		if sort.Descending {
+			direction = "desc"
+		}
+
+		filter.Sort = append(filter.Sort, map[string]any{
+			"direction":   direction,
+			FieldQueryKey: regularField(params.ObjectName, sort.Field),
+		})
+	}
+
+	if len(params.NextPage) > 0 {
+		filter.Cursor = params.NextPage.String()
+	}
+
+	return c.search(ctx, filter, params.Fields, common.WithPreservedFieldCase(params.PreserveFieldCase))
+}
+
+func makeSearchQuery(objectName string, filter common.SearchFilter) (map[string]any, error) {
+	if filter.IsCompound() {
+		queries := make([]map[string]any, 0, len(filter.Filters))
+
+		for _, nested := range filter.Filters {
+			query, err := makeSearchQuery(objectName, nested)
+			if err != nil {
+				return nil, err
+			}
+
+			queries = append(queries, query)
+		}
+
+		return map[string]any{
+			TypeQueryKey: string(filter.Logic),
+			"queries":    queries,
+		}, nil
+	}
+
+	if filter.Operator == common.SearchOperatorNotEquals {
+		equality := filter
+		equality.Operator = common.SearchOperatorEquals
+
+		query, err := makeSearchQuery(objectName, equality)
+		if err != nil {
+			return nil, err
+		}
+
+		return map[string]any{
+			TypeQueryKey: "not",
+			"query":      query,
+		}, nil
+	}
+
+	condition, err := makeSearchCondition(filter)
+	if err != nil {
+		return nil, err
+	}
+
+	return map[string]any{
+		TypeQueryKey:      "field_condition",
+		FieldQueryKey:     regularField(objectName, filter.Field),
+		ConditionQueryKey: condition,
+	}, nil
+}
+
+func makeSearchCondition(filter common.SearchFilter) (map[string]any, error) {
+	switch filter.Operator {
+	case common.SearchOperatorEquals, common.SearchOperatorIn:
+		return map[string]any{
+			TypeQueryKey: "term",
+			"values":     filter.Values(),
+		}, nil
+	case common.SearchOperatorContains:
+		return map[string]any{
+			TypeQueryKey:  "text",
+			"mode":        "phrase",
+			ValueQueryKey: fmt.Sprint(filter.Value),
+		}, nil
+	}
+
+	if moment, ok := filter.Value.(time.Time); ok {
+		key, ok := momentRangeKeys[filter.Operator]
+		if !ok {
+			return nil, common.UnsupportedSearchOperator(filter.Operator)
+		}
+
+		return map[string]any{
+			TypeQueryKey: "moment_range",
+			key: map[string]any{
+				TypeQueryKey:  "fixed_utc",
+				ValueQueryKey: moment.UTC().Format(time.RFC3339),
+			},
+		}, nil
+	}
+
+	key, ok := numberRangeKeys[filter.Operator]
+	if !ok {
+		return nil, common.UnsupportedSearchOperator(filter.Operator)
+	}
+
+	return map[string]any{
+		TypeQueryKey: "number_range",
+		key:          filter.Value,
+	}, nil
+}
+
+func regularField(objectName, fieldName string) map[string]any {
+	return map[string]any{
+		TypeQueryKey:          "regular_field",
+		ObjectTypeQueryKey:    objectName,
+		FieldNameTypeQueryKey: fieldName,
+	}
+}
@@ -34,12 +34,19 @@ func (c *Connector) Search(ctx context.Context, config SearchParams) (*common.Re
 		return nil, err
 	}
 
+	return c.search(ctx, searchFilter, datautils.NewStringSet(config.Fields...),
+		common.WithPreservedFieldCase(config.PreserveFieldCase))
+}
+
+func (c *Connector) search(
+	ctx context.Context, filter Filter, fields datautils.StringSet, options ...common.ParseOption,
+) (*common.ReadResult, error) {
 	url, err := c.getAPIURL(searchEndpoint)
 	if err != nil {
 		return nil, err
 	}
 
-	resp, err := c.Client.Post(ctx, url.String(), searchFilter)
+	resp, err := c.Client.Post(ctx, url.String(), filter)
 	if err != nil {
 		return nil, err
 	}
@@ -49,7 +56,8 @@ func (c *Connector) Search(ctx context.Context, config SearchParams) (*common.Re
 		common.GetRecordsUnderJSONPath("data"),
 		getNextRecordCursor,
 		common.GetMarshaledData,
-		datautils.NewStringSet(config.Fields...),
+		fields,
+		options...,
 	)
 }
 
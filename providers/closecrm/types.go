@@ -12,10 +12,13 @@ type SearchParams struct {
 	Since      time.Time
 	NextPage   common.NextPageToken
 	Filters    Filter
+	// PreserveFieldCase keys ReadResultRow.Fields exactly as fields were requested.
+	PreserveFieldCase bool
 }
 
 type Filter struct {
 	Query  Query               `json:"query"`
+	Sort   []map[string]any    `json:"sort,omitempty"`
 	Cursor any                 `json:"cursor"`
 	Limit  int                 `json:"_limit"`  //nolint:tagliatelle
 	Fields map[string][]string `json:"_fields"` //nolint:tagliatelle
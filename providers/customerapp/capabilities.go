@@ -0,0 +1,19 @@
+package customerapp
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/customerapp/metadata"
+)
+
+// Capabilities is derived from the static schema, the connector is read only.
+// Customer.io has no time filtering, every read is a full read.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return metadata.Schemas.Capabilities(c.Module.ID, func(string) common.ReadCapabilities {
+		return common.ReadCapabilities{
+			Pagination:  common.PaginationCursor,
+			MaxPageSize: DefaultPageSize,
+		}
+	}), nil
+}
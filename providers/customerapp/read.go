@@ -32,5 +32,6 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		makeNextRecordsURL(url),
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
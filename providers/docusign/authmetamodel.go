@@ -4,7 +4,7 @@ import (
 	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
 )
 
-const serverKey = "server"
+const serverKey = catalogreplacer.VariableServer
 
 // Metadata fields that must be specified to initialize connector.
 var requiredMetadataFields = []string{ // nolint:gochecknoglobals
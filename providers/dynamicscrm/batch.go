@@ -0,0 +1,377 @@
+package dynamicscrm
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Dataverse accepts up to 1000 operations within one batch request.
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/execute-batch-operations-using-web-api
+const maxBatchOperations = 1000
+
+var (
+	ErrEmptyBatch         = errors.New("batch must have at least one change set with operations")
+	ErrBatchTooLarge      = errors.New("batch exceeds maximum number of operations")
+	ErrInvalidBatchFormat = errors.New("batch response is not a valid multipart message")
+	ErrChangeSetFailed    = errors.New("change set failed")
+)
+
+// Record id is enclosed in brackets at the end of the OData-EntityId header.
+var entityIDRegex = regexp.MustCompile(`\(([^()]+)\)$`) // nolint:gochecknoglobals
+
+// ChangeSet is an atomic group of operations.
+// Either all operations succeed or all of them are rolled back.
+type ChangeSet []BatchOperation
+
+// BatchOperation is a single create, update or delete inside a ChangeSet.
+// Use BatchWrite or BatchDelete to construct it.
+type BatchOperation struct {
+	method     string
+	objectName string
+	recordID   string
+	body       any
+}
+
+// BatchWrite creates a record, or updates it when RecordId is provided. Mirrors Connector.Write.
+func BatchWrite(params common.WriteParams) BatchOperation {
+	if len(params.RecordId) == 0 {
+		return BatchOperation{
+			method:     http.MethodPost,
+			objectName: params.ObjectName,
+			body:       params.RecordData,
+		}
+	}
+
+	return BatchOperation{
+		method:     http.MethodPatch,
+		objectName: params.ObjectName,
+		recordID:   params.RecordId,
+		body:       params.RecordData,
+	}
+}
+
+// BatchDelete removes a record. Mirrors Connector.Delete.
+func BatchDelete(params common.DeleteParams) BatchOperation {
+	return BatchOperation{
+		method:     http.MethodDelete,
+		objectName: params.ObjectName,
+		recordID:   params.RecordId,
+	}
+}
+
+func (o BatchOperation) resource() string {
+	if len(o.recordID) == 0 {
+		return o.objectName
+	}
+
+	// resource id is passed via brackets in OData spec
+	return fmt.Sprintf("%s(%s)", o.objectName, o.recordID)
+}
+
+// BatchResult holds outcome for every ChangeSet in the order they were sent.
+type BatchResult struct {
+	ChangeSets []ChangeSetResult
+}
+
+// ChangeSetResult describes atomic group outcome.
+// On failure the whole group is rolled back and Error explains the reason.
+type ChangeSetResult struct {
+	Success bool
+	// Responses are listed in the order of operations. Empty when change set failed.
+	Responses []BatchResponse
+	Error     error
+}
+
+// BatchResponse is a response to a single operation.
+type BatchResponse struct {
+	StatusCode int
+	// RecordId is the id of created or updated record, taken from OData-EntityId header.
+	RecordId string // nolint:revive
+	// Data is the response body if any.
+	Data map[string]any
+}
+
+// Batch executes change sets in a single $batch request.
+// Every change set is atomic, while failure of one change set doesn't prevent others from being applied.
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/execute-batch-operations-using-web-api
+func (c *Connector) Batch(ctx context.Context, changeSets ...ChangeSet) (*BatchResult, error) {
+	if err := validateChangeSets(changeSets); err != nil {
+		return nil, err
+	}
+
+	url, err := c.getURL("$batch")
+	if err != nil {
+		return nil, err
+	}
+
+	payload, boundary, err := c.encodeBatch(changeSets)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, body, err := c.Client.HTTPClient.Post(ctx, url.String(), payload,
+		common.Header{Key: "Content-Type", Value: fmt.Sprintf("multipart/mixed; boundary=%v", boundary)},
+		common.Header{Key: "Accept", Value: "application/json"},
+		common.Header{Key: "OData-MaxVersion", Value: "4.0"},
+		common.Header{Key: "OData-Version", Value: "4.0"},
+		common.Header{Key: "Prefer", Value: "odata.continue-on-error"},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeBatch(rsp.Header.Get("Content-Type"), body, changeSets)
+}
+
+func validateChangeSets(changeSets []ChangeSet) error {
+	total := 0
+
+	for _, changeSet := range changeSets {
+		total += len(changeSet)
+	}
+
+	if total == 0 {
+		return ErrEmptyBatch
+	}
+
+	if total > maxBatchOperations {
+		return fmt.Errorf("%w: %v > %v", ErrBatchTooLarge, total, maxBatchOperations)
+	}
+
+	return nil
+}
+
+// encodeBatch produces multipart/mixed body, where every change set is a nested multipart/mixed part.
+// Each operation is an application/http part identified by Content-ID.
+func (c *Connector) encodeBatch(changeSets []ChangeSet) ([]byte, string, error) {
+	var buffer bytes.Buffer
+
+	batchWriter := multipart.NewWriter(&buffer)
+	contentID := 0
+
+	for _, changeSet := range changeSets {
+		var changeSetBuffer bytes.Buffer
+
+		changeSetWriter := multipart.NewWriter(&changeSetBuffer)
+
+		for _, operation := range changeSet {
+			contentID++
+
+			if err := c.encodeOperation(changeSetWriter, operation, contentID); err != nil {
+				return nil, "", err
+			}
+		}
+
+		if err := changeSetWriter.Close(); err != nil {
+			return nil, "", err
+		}
+
+		part, err := batchWriter.CreatePart(textproto.MIMEHeader{
+			"Content-Type": {fmt.Sprintf("multipart/mixed; boundary=%v", changeSetWriter.Boundary())},
+		})
+		if err != nil {
+			return nil, "", err
+		}
+
+		if _, err = part.Write(changeSetBuffer.Bytes()); err != nil {
+			return nil, "", err
+		}
+	}
+
+	if err := batchWriter.Close(); err != nil {
+		return nil, "", err
+	}
+
+	return buffer.Bytes(), batchWriter.Boundary(), nil
+}
+
+func (c *Connector) encodeOperation(writer *multipart.Writer, operation BatchOperation, contentID int) error {
+	url, err := c.getURL(operation.resource())
+	if err != nil {
+		return err
+	}
+
+	part, err := writer.CreatePart(textproto.MIMEHeader{
+		"Content-Type":              {"application/http"},
+		"Content-Transfer-Encoding": {"binary"},
+		"Content-Id":                {strconv.Itoa(contentID)},
+	})
+	if err != nil {
+		return err
+	}
+
+	request := fmt.Sprintf("%v %v HTTP/1.1\r\n", operation.method, url.String())
+
+	if operation.body == nil {
+		_, err = io.WriteString(part, request+"\r\n")
+
+		return err
+	}
+
+	data, err := json.Marshal(operation.body)
+	if err != nil {
+		return errors.Join(common.ErrRecordDataNotJSON, err)
+	}
+
+	request += "Content-Type: application/json; type=entry\r\n\r\n" + string(data) + "\r\n"
+	_, err = io.WriteString(part, request)
+
+	return err
+}
+
+// decodeBatch reads multipart response.
+// Successful change set is a nested multipart with response per operation.
+// Failed change set is replaced by a single application/http part holding the error.
+func decodeBatch(contentType string, body []byte, changeSets []ChangeSet) (*BatchResult, error) {
+	boundary, err := getBoundary(contentType)
+	if err != nil {
+		return nil, err
+	}
+
+	reader := multipart.NewReader(bytes.NewReader(body), boundary)
+	results := make([]ChangeSetResult, 0, len(changeSets))
+
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.Join(ErrInvalidBatchFormat, err)
+		}
+
+		result, err := decodeChangeSet(part)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, *result)
+	}
+
+	if len(results) != len(changeSets) {
+		return nil, fmt.Errorf("%w: expected %v change sets, got %v",
+			ErrInvalidBatchFormat, len(changeSets), len(results))
+	}
+
+	return &BatchResult{ChangeSets: results}, nil
+}
+
+func decodeChangeSet(part *multipart.Part) (*ChangeSetResult, error) {
+	if !strings.HasPrefix(part.Header.Get("Content-Type"), "multipart/mixed") {
+		// Change set failed as a whole.
+		response, err := decodeResponse(part)
+		if err != nil {
+			return nil, err
+		}
+
+		return &ChangeSetResult{
+			Success: false,
+			Error:   interpretBatchError(response),
+		}, nil
+	}
+
+	boundary, err := getBoundary(part.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, err
+	}
+
+	reader := multipart.NewReader(part, boundary)
+	result := &ChangeSetResult{
+		Success:   true,
+		Responses: make([]BatchResponse, 0),
+	}
+
+	for {
+		operationPart, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+
+		if err != nil {
+			return nil, errors.Join(ErrInvalidBatchFormat, err)
+		}
+
+		response, err := decodeResponse(operationPart)
+		if err != nil {
+			return nil, err
+		}
+
+		if response.StatusCode < 200 || response.StatusCode > 299 {
+			result.Success = false
+			result.Error = interpretBatchError(response)
+		}
+
+		result.Responses = append(result.Responses, *response)
+	}
+
+	return result, nil
+}
+
+// decodeResponse parses application/http part which holds raw HTTP response.
+func decodeResponse(part io.Reader) (*BatchResponse, error) {
+	rsp, err := http.ReadResponse(bufio.NewReader(part), nil)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidBatchFormat, err)
+	}
+
+	defer rsp.Body.Close()
+
+	data, err := io.ReadAll(rsp.Body)
+	if err != nil {
+		return nil, errors.Join(ErrInvalidBatchFormat, err)
+	}
+
+	response := &BatchResponse{
+		StatusCode: rsp.StatusCode,
+	}
+
+	if matches := entityIDRegex.FindStringSubmatch(rsp.Header.Get("OData-EntityId")); len(matches) == 2 { // nolint:gomnd
+		response.RecordId = matches[1]
+	}
+
+	if len(bytes.TrimSpace(data)) != 0 {
+		if err = json.Unmarshal(data, &response.Data); err != nil {
+			return nil, errors.Join(ErrInvalidBatchFormat, common.ErrNotJSON, err)
+		}
+	}
+
+	return response, nil
+}
+
+func interpretBatchError(response *BatchResponse) error {
+	message := ""
+
+	if errorObject, ok := response.Data["error"].(map[string]any); ok {
+		message, _ = errorObject["message"].(string)
+	}
+
+	return fmt.Errorf("%w: status %v: %v", ErrChangeSetFailed, response.StatusCode, message)
+}
+
+func getBoundary(contentType string) (string, error) {
+	mediaType, params, err := mime.ParseMediaType(contentType)
+	if err != nil {
+		return "", errors.Join(ErrInvalidBatchFormat, err)
+	}
+
+	if !strings.HasPrefix(mediaType, "multipart/") || params["boundary"] == "" {
+		return "", fmt.Errorf("%w: unexpected content type %v", ErrInvalidBatchFormat, contentType)
+	}
+
+	return params["boundary"], nil
+}
@@ -0,0 +1,107 @@
+package dynamicscrm
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestBatch(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	// Multipart messages use CRLF line breaks.
+	responseBatch := strings.ReplaceAll(string(testutils.DataFromFile(t, "batch-response.txt")), "\n", "\r\n")
+	batchContentType := "multipart/mixed; boundary=batchresponse_c1bd45c1-dd81-470d-b897-e965846aad2f"
+
+	input := []ChangeSet{{
+		BatchWrite(common.WriteParams{
+			ObjectName: "contacts",
+			RecordData: map[string]any{"firstname": "Heriberto"},
+		}),
+		BatchWrite(common.WriteParams{
+			ObjectName: "contacts",
+			RecordId:   "cdcfa450-cb0c-ea11-a813-000d3a1b1223",
+			RecordData: map[string]any{"fax": "614-555-0122"},
+		}),
+	}, {
+		BatchDelete(common.DeleteParams{
+			ObjectName: "contacts",
+			RecordId:   "00000000-0000-0000-0000-000000000000",
+		}),
+	}}
+
+	tests := []batchTestCase{
+		{
+			Name:         "At least one operation is required",
+			Input:        []ChangeSet{{}},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrEmptyBatch},
+		},
+		{
+			Name:  "Change sets are sent as nested multipart and outcomes are decoded",
+			Input: input,
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentMIME(batchContentType),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/v9.2/$batch"),
+					mockcond.BodyContains("POST "),
+					mockcond.BodyContains("/v9.2/contacts HTTP/1.1\r\n" +
+						"Content-Type: application/json; type=entry\r\n\r\n" + `{"firstname":"Heriberto"}`),
+					mockcond.BodyContains("PATCH "),
+					mockcond.BodyContains("/v9.2/contacts(cdcfa450-cb0c-ea11-a813-000d3a1b1223) HTTP/1.1"),
+					mockcond.BodyContains("DELETE "),
+					mockcond.Header(http.Header{"Prefer": []string{"odata.continue-on-error"}}),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, responseBatch),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *BatchResult) bool {
+				if len(actual.ChangeSets) != 2 { // nolint:gomnd
+					return false
+				}
+
+				first, second := actual.ChangeSets[0], actual.ChangeSets[1]
+
+				return first.Success && first.Error == nil &&
+					len(first.Responses) == 2 &&
+					first.Responses[0].RecordId == "a9c3c4c1-2bae-ef11-b8e8-000d3a1b1223" &&
+					first.Responses[1].StatusCode == http.StatusNoContent &&
+					!second.Success && errors.Is(second.Error, ErrChangeSetFailed) &&
+					strings.Contains(second.Error.Error(), "Does Not Exist")
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+type (
+	batchTestCaseType = testroutines.TestCase[[]ChangeSet, *BatchResult]
+	batchTestCase     batchTestCaseType
+)
+
+func (c batchTestCase) Run(t *testing.T, builder testroutines.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	conn := builder.Build(t, c.Name)
+	output, err := conn.Batch(context.Background(), c.Input...)
+	batchTestCaseType(c).Validate(t, err, output)
+}
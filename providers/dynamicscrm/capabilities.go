@@ -0,0 +1,73 @@
+package dynamicscrm
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// entityDefinitionsResponse lists tables of the environment.
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/entitymetadata
+type entityDefinitionsResponse struct {
+	Value []entityDefinition `json:"value"`
+}
+
+type entityDefinition struct {
+	// EntitySetName is the object name used by the connector, ex: "accounts".
+	EntitySetName         string `json:"EntitySetName"`
+	ChangeTrackingEnabled bool   `json:"ChangeTrackingEnabled"`
+	// Intersect tables of many-to-many relationships are written by associating records.
+	IsIntersect bool `json:"IsIntersect"`
+	// Logical tables are derived from other tables and cannot be written directly.
+	IsLogicalEntity bool `json:"IsLogicalEntity"`
+}
+
+// Capabilities lists tables of the environment as reported by EntityDefinitions.
+// Since filters on "modifiedon" column. In change tracking mode, incremental reading
+// and reading deleted rows are available only for tables with change tracking enabled.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	url, err := c.getURL("EntityDefinitions")
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("$select", "EntitySetName,ChangeTrackingEnabled,IsIntersect,IsLogicalEntity")
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := common.UnmarshalJSON[entityDefinitionsResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := common.NewCapabilities()
+
+	if result == nil {
+		return capabilities, nil
+	}
+
+	for _, entity := range result.Value {
+		if entity.EntitySetName == "" {
+			continue
+		}
+
+		capabilities.WithRead(common.ReadCapabilities{
+			Incremental: !c.changeTracking || entity.ChangeTrackingEnabled,
+			Deleted:     c.changeTracking && entity.ChangeTrackingEnabled,
+			Pagination:  common.PaginationNextURL,
+			MaxPageSize: DefaultPageSize,
+		}, entity.EntitySetName)
+
+		if !entity.IsIntersect && !entity.IsLogicalEntity {
+			capabilities.
+				WithOperation(common.OperationCreate, entity.EntitySetName).
+				WithOperation(common.OperationUpdate, entity.EntitySetName).
+				WithOperation(common.OperationDelete, entity.EntitySetName)
+		}
+	}
+
+	return capabilities, nil
+}
@@ -16,6 +16,8 @@ const apiVersion = "v9.2"
 type Connector struct {
 	BaseURL string
 	Client  *common.JSONHTTPClient
+
+	changeTracking bool
 }
 
 func NewConnector(opts ...Option) (conn *Connector, outErr error) {
@@ -34,6 +36,7 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		Client: &common.JSONHTTPClient{
 			HTTPClient: httpClient,
 		},
+		changeTracking: params.changeTracking,
 	}
 
 	providerInfo, err := providers.ReadInfo(conn.Provider(), &params.Workspace)
@@ -3,9 +3,11 @@ package dynamicscrm
 import (
 	"context"
 	"errors"
+	"strings"
 
 	"github.com/amp-labs/connectors/common/jsonquery"
 	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/internal/datautils"
 	"github.com/spyzhov/ajson"
 )
 
@@ -47,3 +49,117 @@ func getObjectDisplayName(item *ajson.Node, objectName naming.SingularString) (s
 
 	return displayName, nil
 }
+
+// Make a call to EntityDefinition endpoint.
+// We are looking for one field: PrimaryIdAttribute.
+// This is the name of the column holding record ID, it is not always object name suffixed with "id".
+func (c *Connector) getPrimaryIdAttribute(
+	ctx context.Context, objectName naming.SingularString,
+) (string, error) {
+	url, err := c.getEntityDefinitionURL(objectName)
+	if err != nil {
+		return "", err
+	}
+
+	url.WithQueryParam("$select", "PrimaryIdAttribute")
+
+	body, err := c.performGetRequest(ctx, url)
+	if err != nil {
+		return "", err
+	}
+
+	attribute, err := jsonquery.New(body).Str("PrimaryIdAttribute", false)
+	if err != nil {
+		return "", errors.Join(ErrObjectNotFound, err)
+	}
+
+	return *attribute, nil
+}
+
+// Make a call to EntityDefinition endpoint expanding relationships.
+// Returns names of navigation properties, which can be used in $expand clause when reading this object.
+// See https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/query-metadata-web-api#querying-relationship-definitions
+func (c *Connector) getNavigationProperties(
+	ctx context.Context, objectName naming.SingularString,
+) (datautils.StringSet, error) {
+	url, err := c.getEntityDefinitionURL(objectName)
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("$select", "LogicalName")
+	url.WithQueryParam("$expand", strings.Join([]string{
+		"ManyToOneRelationships($select=ReferencingEntityNavigationPropertyName)",
+		"OneToManyRelationships($select=ReferencedEntityNavigationPropertyName)",
+		"ManyToManyRelationships($select=Entity1LogicalName,Entity1NavigationPropertyName,Entity2NavigationPropertyName)",
+	}, ","))
+
+	body, err := c.performGetRequest(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+
+	return extractNavigationProperties(body, objectName)
+}
+
+func extractNavigationProperties(
+	node *ajson.Node, objectName naming.SingularString,
+) (datautils.StringSet, error) {
+	properties := datautils.NewStringSet()
+
+	// Single valued navigation property points from this object to the parent, ex: contact->account.
+	// Collection valued navigation property lists children, ex: account->contacts.
+	for key, propertyName := range map[string]string{
+		"ManyToOneRelationships": "ReferencingEntityNavigationPropertyName",
+		"OneToManyRelationships": "ReferencedEntityNavigationPropertyName",
+	} {
+		relationships, err := jsonquery.New(node).Array(key, true)
+		if err != nil {
+			return nil, errors.Join(ErrObjectNotFound, err)
+		}
+
+		for _, relationship := range relationships {
+			name, err := jsonquery.New(relationship).StrWithDefault(propertyName, "")
+			if err != nil {
+				return nil, errors.Join(ErrObjectNotFound, err)
+			}
+
+			if name != "" {
+				properties.AddOne(name)
+			}
+		}
+	}
+
+	relationships, err := jsonquery.New(node).Array("ManyToManyRelationships", true)
+	if err != nil {
+		return nil, errors.Join(ErrObjectNotFound, err)
+	}
+
+	for _, relationship := range relationships {
+		name, err := getManyToManyNavigationProperty(relationship, objectName)
+		if err != nil {
+			return nil, errors.Join(ErrObjectNotFound, err)
+		}
+
+		if name != "" {
+			properties.AddOne(name)
+		}
+	}
+
+	return properties, nil
+}
+
+// Many-to-many relationship is described from both sides.
+// The navigation property of this object is the one on the matching side.
+func getManyToManyNavigationProperty(relationship *ajson.Node, objectName naming.SingularString) (string, error) {
+	entity1, err := jsonquery.New(relationship).StrWithDefault("Entity1LogicalName", "")
+	if err != nil {
+		return "", err
+	}
+
+	if entity1 == objectName.String() {
+		return jsonquery.New(relationship).StrWithDefault("Entity1NavigationPropertyName", "")
+	}
+
+	return jsonquery.New(relationship).StrWithDefault("Entity2NavigationPropertyName", "")
+}
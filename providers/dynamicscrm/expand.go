@@ -0,0 +1,161 @@
+package dynamicscrm
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// Fields of related entities are requested using slash separator.
+// Ex: "parentcustomerid_account/name" selects name of the parent account,
+// "contact_customer_accounts/*" selects all fields of child accounts.
+// Nested path, ex: "parentcustomerid_account.name", is expanded the same way,
+// but only the nested value is returned, keyed by the requested path.
+const (
+	expansionSeparator = "/"
+	expansionWildcard  = "*"
+)
+
+var (
+	ErrUnknownNavigationProperty = errors.New("navigation property is not defined for object")
+	ErrExpandWithChangeTracking  = errors.New("related entities cannot be expanded in change tracking mode")
+)
+
+// readSelection splits requested fields into object's own fields and navigation properties to $expand.
+type readSelection struct {
+	fields []string
+	// expansions maps navigation property to the list of nested fields.
+	// Empty list means every field of related entity.
+	expansions map[string][]string
+	// objects are navigation properties returned as a whole.
+	objects datautils.StringSet
+	// paths are nested fields returned as is.
+	paths []string
+}
+
+func newReadSelection(fields []string) readSelection {
+	selection := readSelection{
+		fields:     make([]string, 0, len(fields)),
+		expansions: make(map[string][]string),
+		objects:    datautils.NewStringSet(),
+	}
+
+	wildcards := datautils.NewStringSet()
+
+	for _, field := range fields {
+		property, nestedField, found := strings.Cut(field, expansionSeparator)
+		if found {
+			selection.objects.AddOne(property)
+		} else {
+			property, nestedField, found = cutNestedPath(field)
+			if !found {
+				selection.fields = append(selection.fields, field)
+
+				continue
+			}
+
+			selection.paths = append(selection.paths, field)
+		}
+
+		if nestedField == expansionWildcard {
+			wildcards.AddOne(property)
+		}
+
+		selection.expansions[property] = append(selection.expansions[property], nestedField)
+	}
+
+	// Wildcard takes precedence over individually listed fields.
+	for _, property := range wildcards.List() {
+		selection.expansions[property] = nil
+	}
+
+	return selection
+}
+
+func (s readSelection) hasExpansions() bool {
+	return len(s.expansions) != 0
+}
+
+func (s readSelection) navigationProperties() []string {
+	properties := datautils.Map[string, []string](s.expansions).Keys()
+	sort.Strings(properties)
+
+	return properties
+}
+
+// expandClause produces value of OData $expand query parameter.
+// Ex: "parentcustomerid_account($select=name,accountid),contact_customer_accounts".
+func (s readSelection) expandClause() string {
+	properties := s.navigationProperties()
+	clauses := make([]string, len(properties))
+
+	for index, property := range properties {
+		nested := s.expansions[property]
+		if len(nested) == 0 {
+			clauses[index] = property
+
+			continue
+		}
+
+		nested = append([]string{}, nested...)
+		sort.Strings(nested)
+		clauses[index] = fmt.Sprintf("%v($select=%v)", property, strings.Join(nested, ","))
+	}
+
+	return strings.Join(clauses, ",")
+}
+
+// outputFields are keys of ReadResultRow.Fields.
+// Related entities are returned as nested objects under navigation property name.
+func (s readSelection) outputFields() datautils.StringSet {
+	output := datautils.NewStringSet(s.fields...)
+	output.Add(s.objects.List())
+	output.Add(s.paths)
+
+	return output
+}
+
+// cutNestedPath splits dotted path or JSONPath into navigation property and the field of related entity.
+// Annotations, ex: "statuscode@OData.Community.Display.V1.FormattedValue", are not paths.
+func cutNestedPath(field string) (property string, nestedField string, found bool) {
+	if strings.Contains(field, "@") || !jsonquery.IsNestedPath(field) {
+		return "", "", false
+	}
+
+	keys, err := jsonquery.ParsePath(field)
+	if err != nil || len(keys) < 2 { // nolint:gomnd
+		return "", "", false
+	}
+
+	return keys[0], keys[1], true
+}
+
+// validateExpansions checks that every navigation property is defined for the object.
+func (c *Connector) validateExpansions(ctx context.Context, objectName string, selection readSelection) error {
+	if !selection.hasExpansions() {
+		return nil
+	}
+
+	if c.changeTracking {
+		return ErrExpandWithChangeTracking
+	}
+
+	properties, err := c.getNavigationProperties(ctx, naming.NewSingularString(objectName))
+	if err != nil {
+		return err
+	}
+
+	for _, property := range selection.navigationProperties() {
+		if !properties.Has(property) {
+			return fmt.Errorf("%w: %v", ErrUnknownNavigationProperty, property)
+		}
+	}
+
+	return nil
+}
@@ -22,6 +22,8 @@ type Option = func(params *parameters)
 type parameters struct {
 	paramsbuilder.Client
 	paramsbuilder.Workspace
+
+	changeTracking bool
 }
 
 func (p parameters) ValidateParams() error {
@@ -50,3 +52,12 @@ func WithWorkspace(workspaceRef string) Option {
 		params.WithWorkspace(workspaceRef)
 	}
 }
+
+// WithChangeTracking makes Read use Dataverse change tracking.
+// Every object must have change tracking enabled in the environment.
+// See https://learn.microsoft.com/en-us/power-apps/developer/data-platform/use-change-tracking-synchronize-data-external-systems
+func WithChangeTracking() Option {
+	return func(params *parameters) {
+		params.changeTracking = true
+	}
+}
@@ -1,6 +1,9 @@
 package dynamicscrm
 
 import (
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/jsonquery"
 	"github.com/spyzhov/ajson"
 )
@@ -17,3 +20,54 @@ func getRecords(node *ajson.Node) ([]map[string]any, error) {
 func getNextRecordsURL(node *ajson.Node) (string, error) {
 	return jsonquery.New(node).StrWithDefault("@odata.nextLink", "")
 }
+
+// getNextOrDeltaURL returns next page link, or delta link once the last page is reached.
+func getNextOrDeltaURL(node *ajson.Node) (string, error) {
+	next, err := getNextRecordsURL(node)
+	if err != nil || next != "" {
+		return next, err
+	}
+
+	return jsonquery.New(node).StrWithDefault("@odata.deltaLink", "")
+}
+
+func isDeltaLink(link string) bool {
+	return strings.Contains(link, "$deltatoken=")
+}
+
+// makeChangedRecordsGetter returns records of the change tracking response.
+// Removed rows are listed alongside changed rows and are marked with "reason" property.
+// See https://learn.microsoft.com/en-us/power-apps/developer/data-platform/use-change-tracking-synchronize-data-external-systems
+func makeChangedRecordsGetter(onlyDeleted bool) common.RecordsFunc {
+	return func(node *ajson.Node) ([]map[string]any, error) {
+		records, err := getRecords(node)
+		if err != nil {
+			return nil, err
+		}
+
+		if !onlyDeleted {
+			return records, nil
+		}
+
+		deleted := make([]map[string]any, 0)
+
+		for _, record := range records {
+			if isDeletedRecord(record) {
+				deleted = append(deleted, record)
+			}
+		}
+
+		return deleted, nil
+	}
+}
+
+func isDeletedRecord(record map[string]any) bool {
+	reason, _ := record["reason"].(string)
+	if reason == "deleted" {
+		return true
+	}
+
+	odataContext, _ := record["@odata.context"].(string)
+
+	return strings.HasSuffix(odataContext, "$deletedEntity")
+}
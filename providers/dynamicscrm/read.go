@@ -5,45 +5,104 @@ import (
 	"errors"
 	"fmt"
 	"strings"
+	"time"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/urlbuilder"
 	"github.com/spyzhov/ajson"
 )
 
+// ErrDeletedRequiresChangeTracking is returned when deleted rows are requested without change tracking.
+// Dataverse keeps no record of removed rows other than the change tracking delta.
+var ErrDeletedRequiresChangeTracking = errors.New("reading deleted records requires change tracking mode")
+
 // nolint:lll
 // Microsoft API supports other capabilities like filtering, grouping, and sorting which we can potentially tap into later.
 // See https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/query-data-web-api#odata-query-options
+//
+// Incremental reading is done either by filtering on "modifiedon" when Since is set,
+// or, if connector is created WithChangeTracking, by following delta links.
+// In change tracking mode the last page returns delta link as NextPage while Done is true.
+// Persist this token and pass it as NextPage to receive only new, updated and deleted rows.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	if err := config.ValidateParams(true); err != nil {
 		return nil, err
 	}
 
-	url, err := c.buildReadURL(config)
+	if config.Deleted && !c.changeTracking {
+		return nil, ErrDeletedRequiresChangeTracking
+	}
+
+	selection := newReadSelection(config.Fields.List())
+
+	// Next page links already include validated $expand clause.
+	if len(config.NextPage) == 0 {
+		if err := c.validateExpansions(ctx, config.ObjectName, selection); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := c.buildReadURL(config, selection)
 	if err != nil {
 		return nil, err
 	}
 
 	// always include annotations header
 	// response will describe enums, foreign relationship, etc.
-	rsp, err := c.Client.Get(ctx, url.String(), newPaginationHeader(DefaultPageSize), common.Header{
-		Key:   "Prefer",
-		Value: `odata.include-annotations="*"`,
-	})
+	headers := []common.Header{
+		newPaginationHeader(DefaultPageSize),
+		{
+			Key:   "Prefer",
+			Value: `odata.include-annotations="*"`,
+		},
+	}
+
+	if c.changeTracking {
+		headers = append(headers, common.Header{
+			Key:   "Prefer",
+			Value: "odata.track-changes",
+		})
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String(), headers...)
 	if err != nil {
 		return nil, err
 	}
 
-	return common.ParseResult(
+	if !c.changeTracking {
+		return common.ParseResult(
+			rsp,
+			getRecords,
+			getNextRecordsURL,
+			common.GetMarshaledData,
+			selection.outputFields(),
+			common.WithPreservedFieldCase(config.PreserveFieldCase),
+		)
+	}
+
+	result, err := common.ParseResult(
 		rsp,
-		getRecords,
-		getNextRecordsURL,
+		makeChangedRecordsGetter(config.Deleted),
+		getNextOrDeltaURL,
 		common.GetMarshaledData,
-		config.Fields,
+		selection.outputFields(),
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
+		// Pages without removed rows are empty, yet the sync continues until the delta link.
+		common.WithClientSideFilter(),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Delta link concludes current sync, it is a bookmark for the next one.
+	if isDeltaLink(result.NextPage.String()) {
+		result.Done = true
+	}
+
+	return result, nil
 }
 
-func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, error) {
+func (c *Connector) buildReadURL(config common.ReadParams, selection readSelection) (*urlbuilder.URL, error) {
 	if len(config.NextPage) != 0 {
 		// Next page
 		return constructURL(config.NextPage.String())
@@ -55,9 +114,18 @@ func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, err
 		return nil, err
 	}
 
-	fields := config.Fields.List()
-	if len(fields) != 0 {
-		url.WithQueryParam("$select", strings.Join(fields, ","))
+	if len(selection.fields) != 0 {
+		url.WithQueryParam("$select", strings.Join(selection.fields, ","))
+	}
+
+	if selection.hasExpansions() {
+		url.WithQueryParam("$expand", selection.expandClause())
+	}
+
+	// Change tracking doesn't allow $filter, initial request returns all rows.
+	if !config.Since.IsZero() && !c.changeTracking {
+		url.WithQueryParam("$filter", fmt.Sprintf("modifiedon gt %v",
+			config.Since.UTC().Format(time.RFC3339)))
 	}
 
 	return url, nil
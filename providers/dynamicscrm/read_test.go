@@ -1,13 +1,17 @@
 package dynamicscrm
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
 	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
 	"github.com/amp-labs/connectors/test/utils/testroutines"
 	"github.com/amp-labs/connectors/test/utils/testutils"
@@ -141,11 +145,323 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop
 	}
 }
 
-func constructTestConnector(serverURL string) (*Connector, error) {
-	connector, err := NewConnector(
+func TestReadIncremental(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseContactsGet := testutils.DataFromFile(t, "contacts-read.json")
+
+	tests := []testroutines.Read{
+		{
+			Name: "Since is converted into modifiedon filter",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				Since:      time.Date(2024, 3, 4, 10, 20, 30, 0, time.UTC),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/v9.2/contacts"),
+					mockcond.QueryParam("$filter", "modifiedon gt 2024-03-04T10:20:30Z"),
+					mockcond.Header(http.Header{"Prefer": []string{
+						"odata.maxpagesize=100", `odata.include-annotations="*"`,
+					}}),
+				},
+				Then: mockserver.Response(http.StatusOK, responseContactsGet),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return actual.Rows == expected.Rows
+			},
+			Expected:     &common.ReadResult{Rows: 2},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Deleted records cannot be read without change tracking",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				Deleted:    true,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrDeletedRequiresChangeTracking},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestReadChangeTracking(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseDelta := testutils.DataFromFile(t, "contacts-delta.json")
+	deltaLink := "https://org5bd08fdd.api.crm.dynamics.com/api/data/v9.2/contacts?$select=fullname&$deltatoken=919042%2108%2f22%2f2017%2008%3a10%3a44" // nolint:lll
+
+	tests := []testroutines.Read{
+		{
+			Name: "Delta link is returned as next page of completed read",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				Since:      time.Now(),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.QueryParamsMissing("$filter"),
+					mockcond.Header(http.Header{"Prefer": []string{"odata.track-changes"}}),
+				},
+				Then: mockserver.Response(http.StatusOK, responseDelta),
+			}.Server(),
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"fullname": "Heriberto Nathan",
+					},
+					Raw: map[string]any{
+						"@odata.etag": "W/\"4372108\"",
+						"fullname":    "Heriberto Nathan",
+						"contactid":   "cdcfa450-cb0c-ea11-a813-000d3a1b1223",
+					},
+				}, {
+					Fields: map[string]any{},
+					Raw: map[string]any{
+						"@odata.context": "https://org5bd08fdd.api.crm.dynamics.com/api/data/v9.2/$metadata#contacts/$deletedEntity", // nolint:lll
+						"id":             "9fd4a450-cb0c-ea11-a813-000d3a1b1223",
+						"reason":         "deleted",
+					},
+				}},
+				NextPage: common.NextPageToken(deltaLink),
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Only deleted rows are returned when requested",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				Deleted:    true,
+			},
+			Server: mockserver.Fixed{
+				Setup:  mockserver.ContentJSON(),
+				Always: mockserver.Response(http.StatusOK, responseDelta),
+			}.Server(),
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{},
+					Raw: map[string]any{
+						"@odata.context": "https://org5bd08fdd.api.crm.dynamics.com/api/data/v9.2/$metadata#contacts/$deletedEntity", // nolint:lll
+						"id":             "9fd4a450-cb0c-ea11-a813-000d3a1b1223",
+						"reason":         "deleted",
+					},
+				}},
+				NextPage: common.NextPageToken(deltaLink),
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Expand is not supported with change tracking",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname", "parentcustomerid_account/*"),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrExpandWithChangeTracking},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL, WithChangeTracking())
+			})
+		})
+	}
+}
+
+func TestReadChangeTrackingEmptyPage(t *testing.T) {
+	t.Parallel()
+
+	responseDelta := testutils.DataFromFile(t, "contacts-delta.json")
+	deltaLink := "https://org5bd08fdd.api.crm.dynamics.com/api/data/v9.2/contacts?$select=fullname&$deltatoken=919042%2108%2f22%2f2017%2008%3a10%3a44" // nolint:lll
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: []mockserver.Case{{
+			If:   mockcond.QueryParam("$skiptoken", "2"),
+			Then: mockserver.Response(http.StatusOK, responseDelta),
+		}},
+		// First page has no removed rows.
+		Default: func(w http.ResponseWriter, r *http.Request) {
+			_, _ = w.Write([]byte(`{
+				"@odata.nextLink": "http://` + r.Host + `/api/data/v9.2/contacts?$skiptoken=2",
+				"value": [{"fullname": "Heriberto Nathan", "contactid": "cdcfa450-cb0c-ea11-a813-000d3a1b1223"}]
+			}`))
+		},
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL, WithChangeTracking())
+	if err != nil {
+		t.Fatalf("failed to create connector: %v", err)
+	}
+
+	config := common.ReadParams{
+		ObjectName: "contacts",
+		Fields:     connectors.Fields("fullname"),
+		Deleted:    true,
+	}
+
+	firstPage, err := connector.Read(context.Background(), config)
+	if err != nil {
+		t.Fatalf("failed to read first page: %v", err)
+	}
+
+	if firstPage.Rows != 0 || firstPage.Done || firstPage.NextPage == "" {
+		t.Fatalf("empty page must continue to the next page, got: (%+v)", firstPage)
+	}
+
+	config.NextPage = firstPage.NextPage
+
+	lastPage, err := connector.Read(context.Background(), config)
+	if err != nil {
+		t.Fatalf("failed to read last page: %v", err)
+	}
+
+	if lastPage.Rows != 1 || !lastPage.Done || lastPage.NextPage.String() != deltaLink {
+		t.Fatalf("last page must return deleted row and delta link, got: (%+v)", lastPage)
+	}
+}
+
+func TestReadExpand(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseNavigation := testutils.DataFromFile(t, "contact-navigation.json")
+	responseContactsExpand := testutils.DataFromFile(t, "contacts-read-expand.json")
+
+	navigationCase := mockserver.Case{
+		If:   mockcond.PathSuffix("EntityDefinitions(LogicalName='contact')"),
+		Then: mockserver.Response(http.StatusOK, responseNavigation),
+	}
+
+	tests := []testroutines.Read{
+		{
+			Name: "Unknown navigation property is rejected",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname", "parentcustomerid_lead/fullname"),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{navigationCase},
+			}.Server(),
+			ExpectedErrs: []error{ErrUnknownNavigationProperty},
+		},
+		{
+			Name: "Related entity fields are expanded and returned as nested object",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname", "parentcustomerid_account/name"),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{navigationCase, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/v9.2/contacts"),
+						mockcond.QueryParam("$select", "fullname"),
+						mockcond.QueryParam("$expand", "parentcustomerid_account($select=name)"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseContactsExpand),
+				}},
+			}.Server(),
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"fullname": "Heriberto Nathan",
+						"parentcustomerid_account": map[string]any{
+							"name":      "Northwind Traders",
+							"accountid": "b2cfa450-cb0c-ea11-a813-000d3a1b1223",
+						},
+					},
+				}},
+				Done: true,
+			},
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Rows == expected.Rows && actual.Done == expected.Done
+			},
+			ExpectedErrs: nil,
+		}, {
+			Name: "Nested path is expanded and returned under requested key",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname", "parentcustomerid_account.name"),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{navigationCase, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/v9.2/contacts"),
+						mockcond.QueryParam("$select", "fullname"),
+						mockcond.QueryParam("$expand", "parentcustomerid_account($select=name)"),
+					},
+					Then: mockserver.Response(http.StatusOK, responseContactsExpand),
+				}},
+			}.Server(),
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"fullname":                      "Heriberto Nathan",
+						"parentcustomerid_account.name": "Northwind Traders",
+					},
+				}},
+				Done: true,
+			},
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					len(actual.Data[0].Fields) == len(expected.Data[0].Fields)
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func constructTestConnector(serverURL string, opts ...Option) (*Connector, error) {
+	connector, err := NewConnector(append([]Option{
 		WithAuthenticatedClient(http.DefaultClient),
 		WithWorkspace("test-workspace"),
-	)
+	}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,94 @@
+package dynamicscrm
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
+)
+
+// GetRecordByID returns a record of the object with the given ID.
+func (c *Connector) GetRecordByID(ctx context.Context, params common.GetRecordParams) (*common.ReadResultRow, error) {
+	return common.GetRecordViaBatch(ctx, params, c.GetRecords)
+}
+
+// GetRecords returns records of the object filtering by IDs using the In query function.
+// Each batch of IDs fits into a single page.
+// nolint:lll
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/reference/in?view=dataverse-latest
+func (c *Connector) GetRecords(ctx context.Context, params common.GetRecordsParams) ([]common.ReadResultRow, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	selection := newReadSelection(params.Fields.List())
+
+	if err := c.validateExpansions(ctx, params.ObjectName, selection); err != nil {
+		return nil, err
+	}
+
+	primaryId, err := c.getPrimaryIdAttribute(ctx, naming.NewSingularString(params.ObjectName))
+	if err != nil {
+		return nil, err
+	}
+
+	rows := make([]common.ReadResultRow, 0, len(params.RecordIds))
+
+	for _, batch := range params.Batches(DefaultPageSize) {
+		url, err := c.getURL(params.ObjectName)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(selection.fields) != 0 {
+			url.WithQueryParam("$select", strings.Join(selection.fields, ","))
+		}
+
+		if selection.hasExpansions() {
+			url.WithQueryParam("$expand", selection.expandClause())
+		}
+
+		url.WithQueryParam("$filter", makeInFilter(primaryId, batch))
+
+		rsp, err := c.Client.Get(ctx, url.String(),
+			newPaginationHeader(DefaultPageSize),
+			common.Header{
+				Key:   "Prefer",
+				Value: `odata.include-annotations="*"`,
+			},
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := common.ParseResult(
+			rsp,
+			getRecords,
+			getNextRecordsURL,
+			common.GetMarshaledData,
+			selection.outputFields(),
+			common.WithPreservedFieldCase(params.PreserveFieldCase),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, result.Data...)
+	}
+
+	return rows, nil
+}
+
+// makeInFilter matches any of the record IDs.
+// Example: Microsoft.Dynamics.CRM.In(PropertyName='accountid',PropertyValues=['a1','b2']).
+func makeInFilter(primaryId string, recordIds []string) string {
+	values := make([]string, len(recordIds))
+	for index, id := range recordIds {
+		values[index] = odataString(id)
+	}
+
+	return fmt.Sprintf("Microsoft.Dynamics.CRM.In(PropertyName=%v,PropertyValues=[%v])",
+		odataString(primaryId), strings.Join(values, ","))
+}
@@ -0,0 +1,87 @@
+package dynamicscrm
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestGetRecords(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.GetRecords{
+		{
+			Name:         "Object name is required",
+			Input:        common.GetRecordsParams{RecordIds: []string{"1"}, Fields: connectors.Fields("fullname")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingObjects},
+		},
+		{
+			Name: "Records are filtered by primary ID attribute",
+			Input: common.GetRecordsParams{
+				ObjectName: "emails",
+				RecordIds: []string{
+					"2a3f4b0d-8d5e-ef11-bfe2-6045bd0065e5",
+					"5c1e8a27-8d5e-ef11-bfe2-6045bd0065e5",
+				},
+				Fields: connectors.Fields("subject"),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.PathSuffix("/v9.2/EntityDefinitions(LogicalName='email')"),
+						mockcond.QueryParam("$select", "PrimaryIdAttribute"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{
+						"PrimaryIdAttribute": "activityid",
+						"MetadataId": "c3b3e4c5-3d5e-4cfa-9a0a-7a3b0a0e0e0e"
+					}`),
+				}, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/v9.2/emails"),
+						mockcond.QueryParam("$select", "subject"),
+						mockcond.QueryParam("$filter", "Microsoft.Dynamics.CRM.In(PropertyName='activityid',"+
+							"PropertyValues=['2a3f4b0d-8d5e-ef11-bfe2-6045bd0065e5','5c1e8a27-8d5e-ef11-bfe2-6045bd0065e5'])"),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{
+						"@odata.context": "https://org5bd08fdd.api.crm.dynamics.com/api/data/v9.2/$metadata#emails(subject)",
+						"value": [{
+							"@odata.etag": "W/\"4372108\"",
+							"subject": "Welcome aboard",
+							"activityid": "2a3f4b0d-8d5e-ef11-bfe2-6045bd0065e5"
+						}]
+					}`),
+				}},
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected []common.ReadResultRow) bool {
+				return mockutils.ReadResultComparator.SubsetFields(
+					&common.ReadResult{Rows: int64(len(actual)), Data: actual},
+					&common.ReadResult{Rows: int64(len(expected)), Data: expected},
+				)
+			},
+			Expected: []common.ReadResultRow{{
+				Fields: map[string]any{"subject": "Welcome aboard"},
+			}},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.RecordConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
@@ -0,0 +1,195 @@
+package dynamicscrm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+)
+
+// odataOperators maps provider-neutral operators to OData comparison operators.
+var odataOperators = map[common.SearchOperator]string{ // nolint:gochecknoglobals
+	common.SearchOperatorEquals:             "eq",
+	common.SearchOperatorNotEquals:          "ne",
+	common.SearchOperatorGreaterThan:        "gt",
+	common.SearchOperatorGreaterThanOrEqual: "ge",
+	common.SearchOperatorLessThan:           "lt",
+	common.SearchOperatorLessThanOrEqual:    "le",
+}
+
+// odataExpression renders filter as OData $filter expression.
+var odataExpression = common.SearchExpression{ // nolint:gochecknoglobals
+	And:       "and",
+	Or:        "or",
+	Condition: makeODataCondition,
+}
+
+// SearchRecords implements connectors.SearchConnector by translating filter into OData $filter query.
+// Values are escaped and names are validated, so the filter is safe to build from user input.
+// nolint:lll
+// https://learn.microsoft.com/en-us/power-apps/developer/data-platform/webapi/query/filter-rows
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateIdentifiers(); err != nil {
+		return nil, err
+	}
+
+	selection := newReadSelection(params.Fields.List())
+
+	if len(params.NextPage) == 0 {
+		if err := c.validateExpansions(ctx, params.ObjectName, selection); err != nil {
+			return nil, err
+		}
+	}
+
+	url, err := c.buildSearchURL(params, selection)
+	if err != nil {
+		return nil, err
+	}
+
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = DefaultPageSize
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String(),
+		newPaginationHeader(pageSize),
+		common.Header{
+			Key:   "Prefer",
+			Value: `odata.include-annotations="*"`,
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		getRecords,
+		getNextRecordsURL,
+		common.GetMarshaledData,
+		selection.outputFields(),
+		common.WithPreservedFieldCase(params.PreserveFieldCase),
+	)
+}
+
+func (c *Connector) buildSearchURL(params common.SearchParams, selection readSelection) (*urlbuilder.URL, error) {
+	if len(params.NextPage) != 0 {
+		return constructURL(params.NextPage.String())
+	}
+
+	url, err := c.getURL(params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(selection.fields) != 0 {
+		url.WithQueryParam("$select", strings.Join(selection.fields, ","))
+	}
+
+	if selection.hasExpansions() {
+		url.WithQueryParam("$expand", selection.expandClause())
+	}
+
+	if !params.Filter.IsEmpty() {
+		filter, err := odataExpression.Render(params.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		url.WithQueryParam("$filter", filter)
+	}
+
+	if len(params.Sort) != 0 {
+		orders := make([]string, len(params.Sort))
+
+		for index, sort := range params.Sort {
+			orders[index] = sort.Field
+			if sort.Descending {
+				orders[index] += " desc"
+			}
+		}
+
+		url.WithQueryParam("$orderby", strings.Join(orders, ","))
+	}
+
+	return url, nil
+}
+
+func makeODataCondition(condition common.SearchFilter) (string, error) {
+	switch condition.Operator {
+	case common.SearchOperatorIn:
+		// In operator is a Dataverse function, it is expressed as a disjunction of equalities instead.
+		values := condition.Values()
+		equalities := make([]string, len(values))
+
+		for index, value := range values {
+			literal, err := odataLiteral(value)
+			if err != nil {
+				return "", err
+			}
+
+			equalities[index] = fmt.Sprintf("%v eq %v", condition.Field, literal)
+		}
+
+		if len(equalities) == 1 {
+			return equalities[0], nil
+		}
+
+		return "(" + strings.Join(equalities, " or ") + ")", nil
+	case common.SearchOperatorContains:
+		text, ok := condition.Value.(string)
+		if !ok {
+			return "", fmt.Errorf("%w: %T for %v", common.ErrUnsupportedSearchValue, condition.Value, condition.Operator)
+		}
+
+		return fmt.Sprintf("contains(%v,%v)", condition.Field, odataString(text)), nil
+	}
+
+	operator, ok := odataOperators[condition.Operator]
+	if !ok {
+		return "", common.UnsupportedSearchOperator(condition.Operator)
+	}
+
+	literal, err := odataLiteral(condition.Value)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%v %v %v", condition.Field, operator, literal), nil
+}
+
+// odataLiteral formats value, strings are quoted while dates, numbers and booleans are not.
+// Other types are rejected, they have no OData literal and must not reach the query as raw text.
+func odataLiteral(value any) (string, error) {
+	switch typed := value.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return odataString(typed), nil
+	case time.Time:
+		return typed.UTC().Format(time.RFC3339), nil
+	case bool:
+		return strconv.FormatBool(typed), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(typed), nil
+	case float32:
+		return strconv.FormatFloat(float64(typed), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("%w: %T", common.ErrUnsupportedSearchValue, value)
+	}
+}
+
+// odataString quotes text with single quotes doubled.
+func odataString(text string) string {
+	return "'" + strings.ReplaceAll(text, "'", "''") + "'"
+}
@@ -0,0 +1,74 @@
+package dynamicscrm
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestSearchRecords(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseContactsGet := testutils.DataFromFile(t, "contacts-read.json")
+
+	tests := []testroutines.Search{
+		{
+			Name: "Value without OData literal is rejected",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				Filter:     common.SearchCondition("statecode", common.SearchOperatorEquals, struct{}{}),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedSearchValue},
+		},
+		{
+			Name: "Filter and sort are converted into OData query",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("fullname"),
+				Filter: common.SearchAll(
+					common.SearchCondition("emailaddress1", common.SearchOperatorEquals, "o'hara@example.com"),
+					common.SearchCondition("statecode", common.SearchOperatorIn, []int{0, 1}),
+					common.SearchCondition("fullname", common.SearchOperatorContains, "Hara"),
+				),
+				Sort:     []common.SearchSort{{Field: "modifiedon", Descending: true}},
+				PageSize: 10,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/v9.2/contacts"),
+					mockcond.QueryParam("$filter", "emailaddress1 eq 'o''hara@example.com' and "+
+						"(statecode eq 0 or statecode eq 1) and contains(fullname,'Hara')"),
+					mockcond.QueryParam("$orderby", "modifiedon desc"),
+					mockcond.Header(http.Header{"Prefer": []string{"odata.maxpagesize=10"}}),
+				},
+				Then: mockserver.Response(http.StatusOK, responseContactsGet),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return actual.Rows == expected.Rows
+			},
+			Expected:     &common.ReadResult{Rows: 2},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.SearchConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
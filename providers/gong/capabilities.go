@@ -0,0 +1,17 @@
+package gong
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Capabilities describes supported objects. Since is passed as fromDateTime query parameter.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return common.NewCapabilities().
+		WithRead(common.ReadCapabilities{
+			Incremental: true,
+			Pagination:  common.PaginationCursor,
+		}, supportedObjectsByRead[c.Module.ID].List()...).
+		WithOperation(common.OperationCreate, supportedObjectsByWrite.List()...), nil
+}
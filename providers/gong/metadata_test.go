@@ -49,6 +49,13 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 							"url":         "url",
 							"workspaceId": "workspaceId",
 						},
+						FieldTypes: common.FieldTypes{
+							"duration":  common.ValueTypeInt,
+							"isPrivate": common.ValueTypeBoolean,
+							"scheduled": common.ValueTypeDateTime,
+							"started":   common.ValueTypeDateTime,
+							"title":     common.ValueTypeString,
+						},
 					},
 				},
 				Errors: nil,
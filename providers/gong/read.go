@@ -42,5 +42,6 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		getNextRecordsURL,
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
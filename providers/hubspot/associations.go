@@ -0,0 +1,467 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Associations API v4 works with both default and labeled associations.
+// Read more @ https://developers.hubspot.com/docs/api/crm/associations
+const associationsAPIVersion = "crm/v4"
+
+var (
+	ErrMissingAssociationObjects = errors.New("associated object types are required")
+	ErrUnknownAssociationLabel   = errors.New("association label is not defined")
+	ErrInvalidAssociations       = errors.New("associations must be a list of hubspot.Association")
+)
+
+// AssociationCategory tells who defined the association type.
+type AssociationCategory string
+
+const (
+	AssociationCategoryHubspotDefined    AssociationCategory = "HUBSPOT_DEFINED"
+	AssociationCategoryUserDefined       AssociationCategory = "USER_DEFINED"
+	AssociationCategoryIntegratorDefined AssociationCategory = "INTEGRATOR_DEFINED"
+)
+
+// AssociationType identifies the kind of relationship, ex: HUBSPOT_DEFINED 279 is "contact to company".
+// Full list of default types: https://developers.hubspot.com/docs/api/crm/associations#association-type-id-values
+type AssociationType struct {
+	Category AssociationCategory `json:"associationCategory"`
+	TypeID   int                 `json:"associationTypeId"`
+}
+
+// Association is used with common.WriteParams.Associations when creating a record.
+// Example: associate new contact with a company:
+//
+//	[]hubspot.Association{{
+//		To:    hubspot.AssociationTarget{ID: "1234"},
+//		Types: []hubspot.AssociationType{{Category: hubspot.AssociationCategoryHubspotDefined, TypeID: 279}},
+//	}}
+//
+// When updating a record, associations are created via Associations API, which requires ToObject.
+type Association struct {
+	To    AssociationTarget `json:"to"`
+	Types []AssociationType `json:"types"`
+	// ToObject is the object of associated record, ex: "companies". Required only for updates.
+	ToObject string `json:"-"`
+}
+
+type AssociationTarget struct {
+	ID string `json:"id"`
+}
+
+// AssociationLabel describes association type between two objects.
+// Unlabeled default association has an empty label.
+type AssociationLabel struct {
+	Category AssociationCategory `json:"category"`
+	TypeID   int                 `json:"typeId"`
+	Label    string              `json:"label"`
+}
+
+// AssociationsParams describe links between records of two objects.
+type AssociationsParams struct {
+	// FromObject is the object of source records, ex: "contacts".
+	FromObject string
+	// ToObject is the object of target records, ex: "companies".
+	ToObject string
+	Links    []AssociationLink
+}
+
+// AssociationLink connects two records.
+// When creating: with no Types and no Label the default association is created.
+// When removing: with no Types and no Label all associations between records are removed,
+// otherwise only given types are removed.
+type AssociationLink struct {
+	FromID string
+	ToID   string
+	Types  []AssociationType
+	// Label is resolved into association type using ListAssociationLabels.
+	Label string
+}
+
+func (p AssociationsParams) ValidateParams() error {
+	if len(p.FromObject) == 0 || len(p.ToObject) == 0 {
+		return ErrMissingAssociationObjects
+	}
+
+	return nil
+}
+
+// ListAssociationLabels returns association types defined between two objects.
+func (c *Connector) ListAssociationLabels(
+	ctx context.Context, fromObject, toObject string,
+) ([]AssociationLabel, error) {
+	rsp, err := c.Client.Get(ctx, c.getAssociationsURL("associations", fromObject, toObject, "labels"))
+	if err != nil {
+		return nil, err
+	}
+
+	labels, err := common.UnmarshalJSON[associationLabelsResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	return labels.Results, nil
+}
+
+// CreateAssociations links records in batch.
+// Default associations and typed associations are created using separate requests.
+func (c *Connector) CreateAssociations(ctx context.Context, params AssociationsParams) error {
+	if err := params.ValidateParams(); err != nil {
+		return err
+	}
+
+	links, err := c.resolveAssociationLabels(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	defaultInputs := make([]associationInput, 0)
+	typedInputs := make([]associationInput, 0)
+
+	for _, link := range links {
+		input := associationInput{
+			From: AssociationTarget{ID: link.FromID},
+			To:   AssociationTarget{ID: link.ToID},
+		}
+
+		if len(link.Types) == 0 {
+			defaultInputs = append(defaultInputs, input)
+		} else {
+			input.Types = link.Types
+			typedInputs = append(typedInputs, input)
+		}
+	}
+
+	if len(defaultInputs) != 0 {
+		url := c.getAssociationsURL("associations", params.FromObject, params.ToObject, "batch/associate/default")
+		if _, err = c.Client.Post(ctx, url, associationBatch{Inputs: defaultInputs}); err != nil {
+			return err
+		}
+	}
+
+	if len(typedInputs) != 0 {
+		url := c.getAssociationsURL("associations", params.FromObject, params.ToObject, "batch/create")
+		if _, err = c.Client.Post(ctx, url, associationBatch{Inputs: typedInputs}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RemoveAssociations unlinks records in batch.
+// Links without types remove every association between the records,
+// links with types remove only those association labels.
+func (c *Connector) RemoveAssociations(ctx context.Context, params AssociationsParams) error {
+	if err := params.ValidateParams(); err != nil {
+		return err
+	}
+
+	links, err := c.resolveAssociationLabels(ctx, params)
+	if err != nil {
+		return err
+	}
+
+	archiveInputs := make([]associationArchiveInput, 0)
+	labelInputs := make([]associationInput, 0)
+
+	for _, link := range links {
+		if len(link.Types) == 0 {
+			archiveInputs = append(archiveInputs, associationArchiveInput{
+				From: AssociationTarget{ID: link.FromID},
+				To:   []AssociationTarget{{ID: link.ToID}},
+			})
+		} else {
+			labelInputs = append(labelInputs, associationInput{
+				From:  AssociationTarget{ID: link.FromID},
+				To:    AssociationTarget{ID: link.ToID},
+				Types: link.Types,
+			})
+		}
+	}
+
+	if len(archiveInputs) != 0 {
+		url := c.getAssociationsURL("associations", params.FromObject, params.ToObject, "batch/archive")
+		if _, err = c.Client.Post(ctx, url, map[string]any{"inputs": archiveInputs}); err != nil {
+			return err
+		}
+	}
+
+	if len(labelInputs) != 0 {
+		url := c.getAssociationsURL("associations", params.FromObject, params.ToObject, "batch/labels/archive")
+		if _, err = c.Client.Post(ctx, url, associationBatch{Inputs: labelInputs}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// parseAssociations checks that WriteParams.Associations holds a list of Association.
+// Associations of updated record are created via Associations API, which requires ToObject.
+func parseAssociations(associations any, update bool) ([]Association, error) {
+	list, ok := associations.([]Association)
+	if !ok {
+		return nil, ErrInvalidAssociations
+	}
+
+	if update {
+		for _, association := range list {
+			if len(association.ToObject) == 0 {
+				return nil, ErrMissingAssociationObjects
+			}
+		}
+	}
+
+	return list, nil
+}
+
+// associateRecord links existing record to the records listed in common.WriteParams.Associations.
+func (c *Connector) associateRecord(
+	ctx context.Context, objectName, recordID string, associations []Association,
+) error {
+	linksByObject := make(map[string][]AssociationLink)
+	objectNames := make([]string, 0)
+
+	for _, association := range associations {
+		if _, ok := linksByObject[association.ToObject]; !ok {
+			objectNames = append(objectNames, association.ToObject)
+		}
+
+		linksByObject[association.ToObject] = append(linksByObject[association.ToObject], AssociationLink{
+			FromID: recordID,
+			ToID:   association.To.ID,
+			Types:  association.Types,
+		})
+	}
+
+	for _, toObject := range objectNames {
+		if err := c.CreateAssociations(ctx, AssociationsParams{
+			FromObject: objectName,
+			ToObject:   toObject,
+			Links:      linksByObject[toObject],
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// resolveAssociationLabels converts labels into association types.
+// Labels are fetched only if at least one link refers to a label.
+func (c *Connector) resolveAssociationLabels(
+	ctx context.Context, params AssociationsParams,
+) ([]AssociationLink, error) {
+	var labels []AssociationLabel
+
+	links := make([]AssociationLink, len(params.Links))
+
+	for index, link := range params.Links {
+		links[index] = link
+
+		if len(link.Label) == 0 {
+			continue
+		}
+
+		if labels == nil {
+			var err error
+
+			labels, err = c.ListAssociationLabels(ctx, params.FromObject, params.ToObject)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		associationType, ok := findAssociationLabel(labels, link.Label)
+		if !ok {
+			return nil, fmt.Errorf("%w: %v between %v and %v",
+				ErrUnknownAssociationLabel, link.Label, params.FromObject, params.ToObject)
+		}
+
+		// Copy avoids modifying caller's slice.
+		links[index].Types = append(append([]AssociationType{}, link.Types...), associationType)
+	}
+
+	return links, nil
+}
+
+func findAssociationLabel(labels []AssociationLabel, label string) (AssociationType, bool) {
+	for _, candidate := range labels {
+		if strings.EqualFold(candidate.Label, label) {
+			return AssociationType{
+				Category: candidate.Category,
+				TypeID:   candidate.TypeID,
+			}, true
+		}
+	}
+
+	return AssociationType{}, false
+}
+
+// fetchAssociations attaches associations to already fetched rows using batch read.
+// It is used for search results, since search endpoint cannot return associations.
+func (c *Connector) fetchAssociations(
+	ctx context.Context, objectName string, rows []common.ReadResultRow, associatedObjects []string,
+) error {
+	inputs := make([]AssociationTarget, 0, len(rows))
+	rowsByID := make(map[string][]int)
+
+	for index, row := range rows {
+		identifier, ok := row.Raw["id"].(string)
+		if !ok {
+			continue
+		}
+
+		if _, seen := rowsByID[identifier]; !seen {
+			inputs = append(inputs, AssociationTarget{ID: identifier})
+		}
+
+		rowsByID[identifier] = append(rowsByID[identifier], index)
+	}
+
+	if len(inputs) == 0 {
+		return nil
+	}
+
+	for _, associatedObject := range associatedObjects {
+		url := c.getAssociationsURL("associations", objectName, associatedObject, "batch/read")
+
+		rsp, err := c.Client.Post(ctx, url, map[string]any{"inputs": inputs})
+		if err != nil {
+			return err
+		}
+
+		batch, err := common.UnmarshalJSON[associationBatchReadResponse](rsp)
+		if err != nil {
+			return err
+		}
+
+		for _, result := range batch.Results {
+			for _, index := range rowsByID[result.From.ID] {
+				if rows[index].Associations == nil {
+					rows[index].Associations = make(map[string][]common.Association)
+				}
+
+				rows[index].Associations[associatedObject] = append(
+					rows[index].Associations[associatedObject], result.toAssociations()...)
+			}
+		}
+	}
+
+	return nil
+}
+
+// parseListAssociations converts "associations" of a record returned by list endpoints.
+// Format: {"companies": {"results": [{"id": "1", "type": "contact_to_company"}]}}.
+func parseListAssociations(record map[string]any) map[string][]common.Association {
+	associationsByObject, ok := record["associations"].(map[string]any)
+	if !ok {
+		return nil
+	}
+
+	result := make(map[string][]common.Association)
+
+	for objectName, value := range associationsByObject {
+		group, ok := value.(map[string]any)
+		if !ok {
+			continue
+		}
+
+		items, _ := group["results"].([]any)
+		associations := make([]common.Association, 0, len(items))
+
+		for _, item := range items {
+			association, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+
+			identifier, _ := association["id"].(string)
+			associationType, _ := association["type"].(string)
+
+			associations = append(associations, common.Association{
+				ObjectId:        identifier,
+				AssociationType: associationType,
+				Raw:             association,
+			})
+		}
+
+		result[objectName] = associations
+	}
+
+	return result
+}
+
+func (c *Connector) getAssociationsURL(parts ...string) string {
+	return c.BaseURL + "/" + path.Join(append([]string{associationsAPIVersion}, parts...)...)
+}
+
+type associationLabelsResponse struct {
+	Results []AssociationLabel `json:"results"`
+}
+
+type associationBatch struct {
+	Inputs []associationInput `json:"inputs"`
+}
+
+type associationInput struct {
+	From  AssociationTarget `json:"from"`
+	To    AssociationTarget `json:"to"`
+	Types []AssociationType `json:"types,omitempty"`
+}
+
+type associationArchiveInput struct {
+	From AssociationTarget   `json:"from"`
+	To   []AssociationTarget `json:"to"`
+}
+
+type associationBatchReadResponse struct {
+	Results []associationBatchReadResult `json:"results"`
+}
+
+type associationBatchReadResult struct {
+	From AssociationTarget `json:"from"`
+	To   []struct {
+		ToObjectID       int64              `json:"toObjectId"`
+		AssociationTypes []AssociationLabel `json:"associationTypes"`
+	} `json:"to"`
+}
+
+// toAssociations produces one association per association type.
+// Type is described by the label, or by type ID when association is unlabeled.
+func (r associationBatchReadResult) toAssociations() []common.Association {
+	associations := make([]common.Association, 0, len(r.To))
+
+	for _, target := range r.To {
+		identifier := strconv.FormatInt(target.ToObjectID, 10)
+
+		for _, associationType := range target.AssociationTypes {
+			description := associationType.Label
+			if len(description) == 0 {
+				description = strconv.Itoa(associationType.TypeID)
+			}
+
+			associations = append(associations, common.Association{
+				ObjectId:        identifier,
+				AssociationType: description,
+				Raw: map[string]any{
+					"toObjectId": identifier,
+					"category":   string(associationType.Category),
+					"typeId":     associationType.TypeID,
+					"label":      associationType.Label,
+				},
+			})
+		}
+	}
+
+	return associations
+}
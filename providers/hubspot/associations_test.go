@@ -0,0 +1,339 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestReadAssociations(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseList := testutils.DataFromFile(t, "read-contacts-associations.json")
+	responseSearch := testutils.DataFromFile(t, "search-contacts.json")
+	responseBatchRead := testutils.DataFromFile(t, "associations-batch-read.json")
+
+	associationsComparator := func(serverURL string, actual, expected *common.ReadResult) bool {
+		if actual.Rows != expected.Rows || len(actual.Data) != len(expected.Data) {
+			return false
+		}
+
+		for index := range expected.Data {
+			if len(actual.Data[index].Associations) != len(expected.Data[index].Associations) {
+				return false
+			}
+
+			for objectName, associations := range expected.Data[index].Associations {
+				if len(actual.Data[index].Associations[objectName]) != len(associations) {
+					return false
+				}
+
+				for position, association := range associations {
+					got := actual.Data[index].Associations[objectName][position]
+					if got.ObjectId != association.ObjectId || got.AssociationType != association.AssociationType {
+						return false
+					}
+				}
+			}
+		}
+
+		return true
+	}
+
+	tests := []testroutines.Read{
+		{
+			Name: "List endpoint returns associations",
+			Input: common.ReadParams{
+				ObjectName:        "contacts",
+				Fields:            connectors.Fields("email"),
+				AssociatedObjects: []string{"companies", "deals"},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/crm/v3/objects/contacts/"),
+					mockcond.QueryParam("associations", "companies,deals"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseList),
+			}.Server(),
+			Comparator: associationsComparator,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Associations: map[string][]common.Association{
+						"companies": {
+							{ObjectId: "2001", AssociationType: "contact_to_company"},
+							{ObjectId: "2001", AssociationType: "contact_to_company_unlabeled"},
+						},
+						"deals": {
+							{ObjectId: "3001", AssociationType: "contact_to_deal"},
+						},
+					},
+				}},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Search results are associated via batch read",
+			Input: common.ReadParams{
+				ObjectName:        "contacts",
+				Fields:            connectors.Fields("email"),
+				Since:             time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC),
+				AssociatedObjects: []string{"companies"},
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/crm/v3/objects/contacts/search"),
+					Then: mockserver.Response(http.StatusOK, responseSearch),
+				}, {
+					If: mockcond.And{
+						mockcond.PathSuffix("/crm/v4/associations/contacts/companies/batch/read"),
+						mockcond.Body(`{"inputs":[{"id":"51"}]}`),
+					},
+					Then: mockserver.Response(http.StatusOK, responseBatchRead),
+				}},
+			}.Server(),
+			Comparator: associationsComparator,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Associations: map[string][]common.Association{
+						"companies": {
+							{ObjectId: "2001", AssociationType: "279"},
+							{ObjectId: "2001", AssociationType: "Manager"},
+						},
+					},
+				}},
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestWriteAssociations(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	associations := []Association{{
+		To:       AssociationTarget{ID: "2001"},
+		Types:    []AssociationType{{Category: AssociationCategoryHubspotDefined, TypeID: 279}},
+		ToObject: "companies",
+	}}
+
+	tests := []testroutines.Write{
+		{
+			Name: "Associations are sent with created record",
+			Input: common.WriteParams{
+				ObjectName:   "contacts",
+				RecordData:   map[string]any{"email": "bh@hubspot.com"},
+				Associations: associations,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/crm/v3/objects/contacts"),
+					mockcond.Body(`{
+						"properties":{"email":"bh@hubspot.com"},
+						"associations":[{"to":{"id":"2001"},
+							"types":[{"associationCategory":"HUBSPOT_DEFINED","associationTypeId":279}]}]
+					}`),
+				},
+				Then: mockserver.ResponseString(http.StatusCreated, `{"id":"51","properties":{"email":"bh@hubspot.com"}}`),
+			}.Server(),
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "51",
+				Data:     map[string]any{"email": "bh@hubspot.com"},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Associations of updated record are created via Associations API",
+			Input: common.WriteParams{
+				ObjectName:   "contacts",
+				RecordId:     "51",
+				RecordData:   map[string]any{"email": "bh@hubspot.com"},
+				Associations: associations,
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.MethodPATCH(),
+						mockcond.PathSuffix("/crm/v3/objects/contacts/51"),
+						mockcond.Body(`{"properties":{"email":"bh@hubspot.com"}}`),
+					},
+					Then: mockserver.ResponseString(http.StatusOK, `{"id":"51","properties":{"email":"bh@hubspot.com"}}`),
+				}, {
+					If: mockcond.And{
+						mockcond.MethodPOST(),
+						mockcond.PathSuffix("/crm/v4/associations/contacts/companies/batch/create"),
+						mockcond.Body(`{"inputs":[{"from":{"id":"51"},"to":{"id":"2001"},
+							"types":[{"associationCategory":"HUBSPOT_DEFINED","associationTypeId":279}]}]}`),
+					},
+					Then: mockserver.ResponseString(http.StatusCreated, `{"status":"COMPLETE","results":[]}`),
+				}},
+			}.Server(),
+			Expected: &common.WriteResult{
+				Success:  true,
+				RecordId: "51",
+				Data:     map[string]any{"email": "bh@hubspot.com"},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Updated record requires associated object name",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordId:   "51",
+				RecordData: map[string]any{"email": "bh@hubspot.com"},
+				Associations: []Association{{
+					To: AssociationTarget{ID: "2001"},
+				}},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.MethodPATCH(),
+				Then:  mockserver.ResponseString(http.StatusOK, `{"id":"51","properties":{}}`),
+			}.Server(),
+			ExpectedErrs: []error{ErrMissingAssociationObjects},
+		},
+		{
+			Name: "Created record rejects malformed associations",
+			Input: common.WriteParams{
+				ObjectName: "contacts",
+				RecordData: map[string]any{"email": "bh@hubspot.com"},
+				Associations: []map[string]any{{
+					"to": map[string]any{"id": "2001"},
+				}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrInvalidAssociations},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.WriteConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestCreateAndRemoveAssociations(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: []mockserver.Case{{
+			If: mockcond.PathSuffix("/crm/v4/associations/contacts/companies/labels"),
+			Then: mockserver.ResponseString(http.StatusOK, `{"results":[
+				{"category":"HUBSPOT_DEFINED","typeId":279,"label":null},
+				{"category":"USER_DEFINED","typeId":36,"label":"Manager"}
+			]}`),
+		}, {
+			If: mockcond.And{
+				mockcond.PathSuffix("/crm/v4/associations/contacts/companies/batch/associate/default"),
+				mockcond.Body(`{"inputs":[{"from":{"id":"51"},"to":{"id":"2001"}}]}`),
+			},
+			Then: mockserver.ResponseString(http.StatusOK, `{"status":"COMPLETE"}`),
+		}, {
+			If: mockcond.And{
+				mockcond.PathSuffix("/crm/v4/associations/contacts/companies/batch/create"),
+				mockcond.Body(`{"inputs":[{"from":{"id":"52"},"to":{"id":"2001"},
+					"types":[{"associationCategory":"USER_DEFINED","associationTypeId":36}]}]}`),
+			},
+			Then: mockserver.ResponseString(http.StatusOK, `{"status":"COMPLETE"}`),
+		}, {
+			If: mockcond.And{
+				mockcond.PathSuffix("/crm/v4/associations/contacts/companies/batch/archive"),
+				mockcond.Body(`{"inputs":[{"from":{"id":"51"},"to":[{"id":"2001"}]}]}`),
+			},
+			Then: mockserver.ResponseString(http.StatusNoContent, ``),
+		}, {
+			If: mockcond.And{
+				mockcond.PathSuffix("/crm/v4/associations/contacts/companies/batch/labels/archive"),
+				mockcond.Body(`{"inputs":[{"from":{"id":"52"},"to":{"id":"2001"},
+					"types":[{"associationCategory":"USER_DEFINED","associationTypeId":36}]}]}`),
+			},
+			Then: mockserver.ResponseString(http.StatusNoContent, ``),
+		}},
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create connector: %v", err)
+	}
+
+	params := AssociationsParams{
+		FromObject: "contacts",
+		ToObject:   "companies",
+		Links: []AssociationLink{
+			{FromID: "51", ToID: "2001"},
+			{FromID: "52", ToID: "2001", Label: "manager"},
+		},
+	}
+
+	if err = connector.CreateAssociations(context.Background(), params); err != nil {
+		t.Fatalf("failed to create associations: %v", err)
+	}
+
+	if err = connector.RemoveAssociations(context.Background(), params); err != nil {
+		t.Fatalf("failed to remove associations: %v", err)
+	}
+
+	if !reflect.DeepEqual(params.Links[1].Types, []AssociationType(nil)) {
+		t.Fatalf("input links must not be modified")
+	}
+
+	params.Links = []AssociationLink{{FromID: "51", ToID: "2001", Label: "Owner"}}
+
+	err = connector.CreateAssociations(context.Background(), params)
+	if !errors.Is(err, ErrUnknownAssociationLabel) {
+		t.Fatalf("expected Error: (%v), got: (%v)", ErrUnknownAssociationLabel, err)
+	}
+}
+
+func constructTestConnector(serverURL string) (*Connector, error) {
+	connector, err := NewConnector(
+		WithAuthenticatedClient(http.DefaultClient),
+		WithModule(ModuleCRM),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// for testing we want to redirect calls to our mock server
+	connector.setBaseURL(serverURL)
+
+	return connector, nil
+}
@@ -0,0 +1,66 @@
+package hubspot
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// crmObjects are standard CRM objects, which are read and written via objects endpoints.
+// https://developers.hubspot.com/docs/api/crm/understanding-the-crm
+var crmObjects = datautils.NewSet( //nolint:gochecknoglobals
+	"contacts",
+	"companies",
+	"deals",
+	"tickets",
+	"products",
+	"line_items",
+	"quotes",
+	"calls",
+	"communications",
+	"emails",
+	"meetings",
+	"notes",
+	"postal_mail",
+	"tasks",
+	"feedback_submissions",
+)
+
+// Feedback submissions are created by surveys and cannot be written via API.
+// https://developers.hubspot.com/docs/api/crm/feedback-submissions
+var readOnlyCRMObjects = datautils.NewSet("feedback_submissions") //nolint:gochecknoglobals
+
+// Capabilities lists standard CRM objects and custom objects defined in the portal.
+// Since is applied via Search API, Deleted reads archived records.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	customObjects, err := c.ListCustomObjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	objectNames := crmObjects.List()
+	for _, schema := range customObjects {
+		objectNames = append(objectNames, schema.FullyQualifiedName)
+	}
+
+	pageSize, _ := strconv.Atoi(DefaultPageSize)
+	capabilities := common.NewCapabilities().
+		WithRead(common.ReadCapabilities{
+			Incremental: true,
+			Deleted:     true,
+			Pagination:  common.PaginationNextURL,
+			MaxPageSize: pageSize,
+		}, objectNames...)
+
+	for _, objectName := range objectNames {
+		if !readOnlyCRMObjects.Has(objectName) {
+			capabilities.
+				WithOperation(common.OperationCreate, objectName).
+				WithOperation(common.OperationUpdate, objectName)
+		}
+	}
+
+	return capabilities, nil
+}
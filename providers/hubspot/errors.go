@@ -16,6 +16,10 @@ var (
 	ErrNotArray         = errors.New("results is not an array")
 	ErrNotObject        = errors.New("result is not an object")
 	ErrNotString        = errors.New("link is not a string")
+
+	// ErrSearchLimitReached is returned when search reaches the result limit
+	// and the query cannot be restarted because the last record has no ID.
+	ErrSearchLimitReached = errors.New("search result limit reached without last record ID")
 )
 
 type HubspotError struct {
@@ -29,6 +29,12 @@ func (c *Connector) ListObjectMetadata( // nolint:cyclop,funlen
 		return nil, common.ErrMissingObjects
 	}
 
+	// Custom objects are described by their schemas, which include properties and labels.
+	schemas, err := c.customObjectSchemas(ctx, objectNames)
+	if err != nil {
+		return nil, err
+	}
+
 	// Use goroutines to fetch metadata for each object in parallel
 	metadataChannel := make(chan *objectMetadataResult, len(objectNames))
 	errChannel := make(chan *objectMetadataError, len(objectNames))
@@ -38,6 +44,15 @@ func (c *Connector) ListObjectMetadata( // nolint:cyclop,funlen
 
 	for _, objectName := range objectNames {
 		go func(object string) {
+			if schema, ok := findCustomObject(schemas, object); ok {
+				metadataChannel <- &objectMetadataResult{
+					ObjectName: object,
+					Response:   *describeCustomObject(schema),
+				}
+
+				return
+			}
+
 			objectMetadata, err := c.describeObject(ctx, object)
 			if err != nil {
 				errChannel <- &objectMetadataError{
@@ -81,6 +96,7 @@ type describeObjectResponse struct {
 type describeObjectResult struct {
 	Name  string `json:"name"`
 	Label string `json:"label"`
+	Type  string `json:"type"`
 }
 
 // describeObject returns object metadata for the given object name.
@@ -112,6 +128,7 @@ func (c *Connector) describeObject(ctx context.Context, objectName string) (*com
 	return &common.ObjectMetadata{
 		DisplayName: objectName,
 		FieldsMap:   makeFieldsMap(resp),
+		FieldTypes:  makeFieldTypes(resp),
 	}, nil
 }
 
@@ -128,3 +145,34 @@ func makeFieldsMap(data *describeObjectResponse) map[string]string {
 
 	return fieldsMap
 }
+
+// makeFieldTypes returns a map of field name to value type.
+func makeFieldTypes(data *describeObjectResponse) common.FieldTypes {
+	fieldTypes := make(common.FieldTypes)
+
+	for _, field := range data.Results {
+		fieldTypes[strings.ToLower(field.Name)] = valueType(field.Type)
+	}
+
+	return fieldTypes
+}
+
+// valueType converts property type into common.ValueType.
+// HubSpot returns every value as a string, ex: numbers "42.5" and datetimes "2024-05-01T10:00:00Z".
+// https://developers.hubspot.com/docs/api/crm/properties#property-type-and-fieldtype-values
+func valueType(propertyType string) common.ValueType {
+	switch propertyType {
+	case "bool":
+		return common.ValueTypeBoolean
+	case "number":
+		return common.ValueTypeFloat
+	case "date":
+		return common.ValueTypeDate
+	case "datetime":
+		return common.ValueTypeDateTime
+	case "string", "enumeration", "phone_number":
+		return common.ValueTypeString
+	default:
+		return common.ValueTypeOther
+	}
+}
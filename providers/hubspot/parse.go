@@ -125,8 +125,9 @@ func getMarshalledData(records []map[string]interface{}, fields []string) ([]com
 		}
 
 		data[i] = common.ReadResultRow{
-			Fields: common.ExtractLowercaseFieldsFromRaw(fields, recordProperties),
-			Raw:    record,
+			Fields:       common.ExtractLowercaseFieldsFromRaw(fields, recordProperties),
+			Raw:          record,
+			Associations: parseListAssociations(record),
 		}
 	}
 
@@ -134,6 +135,7 @@ func getMarshalledData(records []map[string]interface{}, fields []string) ([]com
 }
 
 // GetLastResultId returns the last row's id from a result.
+// The hs_object_id field is used when requested, otherwise the record ID is taken from the raw response.
 func GetLastResultId(result *common.ReadResult) string {
 	numRecords := len(result.Data)
 	if numRecords == 0 {
@@ -143,7 +145,11 @@ func GetLastResultId(result *common.ReadResult) string {
 	// Get the last row and get the hs_object_id field's value
 	lastRow := result.Data[numRecords-1]
 
-	lastRowId, ok := lastRow.Fields[string(ObjectFieldHsObjectId)].(string)
+	if lastRowId, ok := lastRow.Fields[string(ObjectFieldHsObjectId)].(string); ok {
+		return lastRowId
+	}
+
+	lastRowId, ok := lastRow.Raw["id"].(string)
 	if !ok {
 		return ""
 	}
@@ -0,0 +1,83 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"path"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+var (
+	ErrMissingPropertyName = errors.New("property name is required")
+	ErrMissingGroupName    = errors.New("property group name is required")
+)
+
+// Property is a field of a CRM object.
+// Type and FieldType must be a valid pair, ex: "string" with "text", "enumeration" with "select".
+// Read more @ https://developers.hubspot.com/docs/api/crm/properties#property-type-and-fieldtype-values
+type Property struct {
+	Name        string           `json:"name"`
+	Label       string           `json:"label"`
+	Type        string           `json:"type"`
+	FieldType   string           `json:"fieldType"`
+	GroupName   string           `json:"groupName,omitempty"`
+	Description string           `json:"description,omitempty"`
+	Options     []PropertyOption `json:"options,omitempty"`
+	// HasUniqueValue makes the property usable as an idempotency key.
+	HasUniqueValue bool `json:"hasUniqueValue,omitempty"`
+	FormField      bool `json:"formField,omitempty"`
+}
+
+// PropertyOption is a choice of enumeration property.
+type PropertyOption struct {
+	Label        string `json:"label"`
+	Value        string `json:"value"`
+	DisplayOrder int    `json:"displayOrder,omitempty"`
+	Hidden       bool   `json:"hidden,omitempty"`
+}
+
+// PropertyGroup organizes properties in the HubSpot UI.
+type PropertyGroup struct {
+	Name         string `json:"name"`
+	Label        string `json:"label"`
+	DisplayOrder int    `json:"displayOrder,omitempty"`
+}
+
+// CreateProperty adds a property to standard or custom object.
+func (c *Connector) CreateProperty(ctx context.Context, objectName string, property Property) (*Property, error) {
+	if len(objectName) == 0 {
+		return nil, common.ErrMissingObjects
+	}
+
+	if len(property.Name) == 0 {
+		return nil, ErrMissingPropertyName
+	}
+
+	rsp, err := c.Client.Post(ctx, c.getURL(path.Join("properties", objectName)), property)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.UnmarshalJSON[Property](rsp)
+}
+
+// CreatePropertyGroup adds a group to standard or custom object.
+func (c *Connector) CreatePropertyGroup(
+	ctx context.Context, objectName string, group PropertyGroup,
+) (*PropertyGroup, error) {
+	if len(objectName) == 0 {
+		return nil, common.ErrMissingObjects
+	}
+
+	if len(group.Name) == 0 {
+		return nil, ErrMissingGroupName
+	}
+
+	rsp, err := c.Client.Post(ctx, c.getURL(path.Join("properties", objectName, "groups")), group)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.UnmarshalJSON[PropertyGroup](rsp)
+}
@@ -9,9 +9,11 @@ import (
 )
 
 // Read reads data from Hubspot. If Since is set, it will use the
-// Search endpoint instead to filter records, but it will be
-// limited to a maximum of 10,000 records. This is a limit of the
-// search endpoint. If Since is not set, it will use the read endpoint.
+// Search endpoint instead to filter records. The search endpoint is
+// limited to a maximum of 10,000 records per query, therefore records are
+// sorted by ID and the query is restarted from the last seen ID once the limit is reached.
+// This is transparent to the caller, NextPageToken holds the state.
+// If Since is not set, it will use the read endpoint.
 // In case Deleted objects won’t appear in any search results.
 // Deleted objects can only be read by using this endpoint.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
@@ -25,29 +27,8 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 	)
 
 	// If filtering is required, then we have to use the search endpoint.
-	// The Search endpoint has a 10K record limit. In case this limit is reached,
-	// the sorting allows the caller to continue in another call by offsetting
-	// until the ID of the last record that was successfully fetched.
 	if requiresFiltering(config) {
-		searchParams := SearchParams{
-			ObjectName: config.ObjectName,
-			FilterGroups: []FilterGroup{
-				{
-					Filters: []Filter{
-						BuildLastModifiedFilterGroup(&config),
-						// Add more filters to AND them together
-					},
-					// Add more filter groups to OR them together
-				},
-			},
-			SortBy: []SortBy{
-				BuildSort(ObjectFieldHsObjectId, SortDirectionAsc),
-			},
-			NextPage: config.NextPage,
-			Fields:   config.Fields,
-		}
-
-		return c.Search(ctx, searchParams)
+		return c.searchSince(ctx, config)
 	}
 
 	if len(config.NextPage) > 0 {
@@ -71,6 +52,7 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		getNextRecordsURL,
 		getMarshalledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
 
@@ -87,6 +69,10 @@ func makeQueryValues(config common.ReadParams) string {
 		queryValues.Add("archived", "true")
 	}
 
+	if len(config.AssociatedObjects) != 0 {
+		queryValues.Add("associations", strings.Join(config.AssociatedObjects, ","))
+	}
+
 	queryValues.Add("limit", DefaultPageSize)
 
 	return queryValues.Encode()
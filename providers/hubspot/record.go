@@ -4,7 +4,9 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"path"
+	"strings"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/naming"
@@ -31,13 +33,34 @@ var (
 */
 
 // GetRecord returns a record from the object with the given ID and object name.
+//
+// Deprecated: use GetRecordWithAssociations, or GetRecordByID which selects fields like Read does.
 func (c *Connector) GetRecord(ctx context.Context, objectName string, recordId string) (*common.ReadResultRow, error) {
-	if !getRecordSupportedObjectsSet.Has(objectName) {
+	return c.GetRecordWithAssociations(ctx, objectName, recordId, nil)
+}
+
+// GetRecordWithAssociations returns a record together with IDs of associated records, e.g. ["companies"].
+// The object name is singular as in webhook subscription types, e.g. "contact", or a custom object.
+func (c *Connector) GetRecordWithAssociations(
+	ctx context.Context, objectName string, recordId string, associatedObjects []string,
+) (*common.ReadResultRow, error) {
+	var objectPath string
+
+	switch {
+	case IsCustomObject(objectName):
+		// Custom objects are addressed by fully qualified name or object type ID as is.
+		objectPath = objectName
+	case getRecordSupportedObjectsSet.Has(objectName):
+		objectPath = naming.NewPluralString(objectName).String()
+	default:
 		return nil, fmt.Errorf("%w %s", errGerRecordNotSupportedForObject, objectName)
 	}
 
-	pluralObjectName := naming.NewPluralString(objectName).String()
-	relativePath := path.Join("/objects", pluralObjectName, recordId)
+	relativePath := path.Join("/objects", objectPath, recordId)
+
+	if len(associatedObjects) != 0 {
+		relativePath += "?associations=" + url.QueryEscape(strings.Join(associatedObjects, ","))
+	}
 
 	resp, err := c.Client.Get(ctx, c.getURL(relativePath))
 	if err != nil {
@@ -50,6 +73,7 @@ func (c *Connector) GetRecord(ctx context.Context, objectName string, recordId s
 	}
 
 	return &common.ReadResultRow{
-		Raw: *record,
+		Raw:          *record,
+		Associations: parseListAssociations(*record),
 	}, nil
 }
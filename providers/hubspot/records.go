@@ -0,0 +1,78 @@
+package hubspot
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// batchReadSize is the number of records HubSpot returns per batch read request.
+const batchReadSize = 100
+
+type batchReadPayload struct {
+	Properties []string         `json:"properties"`
+	Inputs     []batchReadInput `json:"inputs"`
+}
+
+type batchReadInput struct {
+	Id string `json:"id"`
+}
+
+// GetRecordByID returns a record of the object with the given ID.
+// The object name is the same as for Read, e.g. "contacts".
+func (c *Connector) GetRecordByID(ctx context.Context, params common.GetRecordParams) (*common.ReadResultRow, error) {
+	return common.GetRecordViaBatch(ctx, params, c.GetRecords)
+}
+
+// GetRecords returns records of the object using the batch read endpoint.
+// Records which don't exist are reported by HubSpot as errors of the batch and are omitted.
+// https://developers.hubspot.com/docs/api/crm/contacts#retrieve-contacts-by-record-id-email-or-custom-unique-value-property
+func (c *Connector) GetRecords(ctx context.Context, params common.GetRecordsParams) ([]common.ReadResultRow, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	fields := params.Fields.List()
+	rows := make([]common.ReadResultRow, 0, len(params.RecordIds))
+
+	for _, batch := range params.Batches(batchReadSize) {
+		payload := batchReadPayload{
+			Properties: fields,
+			Inputs:     make([]batchReadInput, len(batch)),
+		}
+
+		for index, id := range batch {
+			payload.Inputs[index] = batchReadInput{Id: id}
+		}
+
+		rsp, err := c.Client.Post(ctx, c.getURL("objects/"+params.ObjectName+"/batch/read"), payload)
+		if err != nil {
+			return nil, err
+		}
+
+		body, ok := rsp.Body()
+		if !ok {
+			return nil, common.ErrEmptyJSONHTTPResponse
+		}
+
+		records, err := getRecords(body)
+		if err != nil {
+			return nil, err
+		}
+
+		data, err := getMarshalledData(records, fields)
+		if err != nil {
+			return nil, err
+		}
+
+		if params.PreserveFieldCase {
+			if err = common.ApplyPreservedFieldCase(records, data, getMarshalledData, fields); err != nil {
+				return nil, err
+			}
+		}
+
+		rows = append(rows, data...)
+	}
+
+	return rows, nil
+}
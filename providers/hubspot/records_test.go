@@ -0,0 +1,67 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestGetRecords(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.GetRecords{
+		{
+			Name:         "At least one ID is required",
+			Input:        common.GetRecordsParams{ObjectName: "contacts", Fields: connectors.Fields("email")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingRecordID},
+		},
+		{
+			Name: "Missing records are omitted from batch read",
+			Input: common.GetRecordsParams{
+				ObjectName: "contacts",
+				RecordIds:  []string{"7001", "7002"},
+				Fields:     connectors.Fields("email"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/crm/v3/objects/contacts/batch/read"),
+					mockcond.Body(`{"properties":["email"],"inputs":[{"id":"7001"},{"id":"7002"}]}`),
+				},
+				Then: mockserver.ResponseString(http.StatusMultiStatus, `{
+					"status": "COMPLETE",
+					"results": [{"id": "7001", "properties": {"email": "ada@example.com", "hs_object_id": "7001"}}],
+					"numErrors": 1,
+					"errors": [{"status": "error", "category": "OBJECT_NOT_FOUND", "context": {"ids": ["7002"]}}]
+				}`),
+			}.Server(),
+			Expected: []common.ReadResultRow{{
+				Fields: map[string]any{"email": "ada@example.com"},
+				Raw: map[string]any{
+					"id":         "7001",
+					"properties": map[string]any{"email": "ada@example.com", "hs_object_id": "7001"},
+				},
+			}},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.RecordConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
@@ -0,0 +1,101 @@
+package hubspot
+
+import (
+	"context"
+	"regexp"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Custom objects are referenced either by fully qualified name, ex: "p1234_cars",
+// or by object type ID, ex: "2-3456". Both forms are accepted by CRM endpoints.
+// Read more @ https://developers.hubspot.com/docs/api/crm/crm-custom-objects
+var customObjectNameRegex = regexp.MustCompile(`^(p\d+_\w+|2-\d+)$`) // nolint:gochecknoglobals
+
+// CustomObjectSchema describes custom object defined in the portal.
+type CustomObjectSchema struct {
+	ID                     string             `json:"id"`
+	Name                   string             `json:"name"`
+	FullyQualifiedName     string             `json:"fullyQualifiedName"`
+	ObjectTypeID           string             `json:"objectTypeId"`
+	Labels                 CustomObjectLabels `json:"labels"`
+	PrimaryDisplayProperty string             `json:"primaryDisplayProperty,omitempty"`
+	Properties             []Property         `json:"properties"`
+	Archived               bool               `json:"archived"`
+}
+
+type CustomObjectLabels struct {
+	Singular string `json:"singular"`
+	Plural   string `json:"plural"`
+}
+
+type customObjectSchemasResponse struct {
+	Results []CustomObjectSchema `json:"results"`
+}
+
+// IsCustomObject returns true if the name refers to a custom object.
+func IsCustomObject(objectName string) bool {
+	return customObjectNameRegex.MatchString(objectName)
+}
+
+// ListCustomObjects returns schemas of custom objects defined in the portal.
+// Use FullyQualifiedName as the object name to read, write and describe records.
+func (c *Connector) ListCustomObjects(ctx context.Context) ([]CustomObjectSchema, error) {
+	rsp, err := c.Client.Get(ctx, c.getURL("schemas"))
+	if err != nil {
+		return nil, err
+	}
+
+	schemas, err := common.UnmarshalJSON[customObjectSchemasResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	return schemas.Results, nil
+}
+
+// customObjectSchemas fetches schemas only if some of the objects are custom.
+func (c *Connector) customObjectSchemas(ctx context.Context, objectNames []string) ([]CustomObjectSchema, error) {
+	for _, objectName := range objectNames {
+		if IsCustomObject(objectName) {
+			return c.ListCustomObjects(ctx)
+		}
+	}
+
+	return nil, nil
+}
+
+// findCustomObject looks up schema by fully qualified name or object type ID.
+func findCustomObject(schemas []CustomObjectSchema, objectName string) (*CustomObjectSchema, bool) {
+	for index, schema := range schemas {
+		if strings.EqualFold(schema.FullyQualifiedName, objectName) ||
+			schema.ObjectTypeID == objectName {
+			return &schemas[index], true
+		}
+	}
+
+	return nil, false
+}
+
+// describeCustomObject converts schema into object metadata.
+func describeCustomObject(schema *CustomObjectSchema) *common.ObjectMetadata {
+	fieldsMap := make(map[string]string)
+	fieldTypes := make(common.FieldTypes)
+
+	for _, property := range schema.Properties {
+		fieldsMap[strings.ToLower(property.Name)] = property.Label
+		fieldTypes[strings.ToLower(property.Name)] = valueType(property.Type)
+	}
+
+	displayName := schema.Labels.Plural
+	if len(displayName) == 0 {
+		displayName = schema.FullyQualifiedName
+	}
+
+	return &common.ObjectMetadata{
+		DisplayName: displayName,
+		FieldsMap:   fieldsMap,
+		FieldTypes:  fieldTypes,
+	}
+}
@@ -0,0 +1,211 @@
+package hubspot
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestListObjectMetadataCustomObjects(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	responseSchemas := testutils.DataFromFile(t, "schemas.json")
+
+	tests := []testroutines.Metadata{
+		{
+			Name:  "Custom object is described by schema, standard object by properties",
+			Input: []string{"p1234_cars", "2-1234", "contacts"},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/crm/v3/schemas"),
+					Then: mockserver.Response(http.StatusOK, responseSchemas),
+				}, {
+					If: mockcond.PathSuffix("/crm/v3/properties/contacts"),
+					Then: mockserver.ResponseString(http.StatusOK,
+						`{"results":[{"name":"email","label":"Email"}]}`),
+				}},
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ListObjectMetadataResult) bool {
+				return mockutils.MetadataResultComparator.SubsetFields(actual, expected)
+			},
+			Expected: &common.ListObjectMetadataResult{
+				Result: map[string]common.ObjectMetadata{
+					"p1234_cars": {
+						DisplayName: "Cars",
+						FieldsMap: map[string]string{
+							"model":        "Model",
+							"year":         "Year",
+							"hs_object_id": "Record ID",
+						},
+						FieldTypes: common.FieldTypes{
+							"model": common.ValueTypeString,
+							"year":  common.ValueTypeFloat,
+						},
+					},
+					"2-1234": {
+						DisplayName: "Cars",
+						FieldsMap: map[string]string{
+							"model": "Model",
+						},
+					},
+					"contacts": {
+						DisplayName: "contacts",
+						FieldsMap: map[string]string{
+							"email": "Email",
+						},
+					},
+				},
+				Errors: make(map[string]error),
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:  "Schemas are not fetched for standard objects",
+			Input: []string{"contacts"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/crm/v3/properties/contacts"),
+				Then: mockserver.ResponseString(http.StatusOK,
+					`{"results":[{"name":"email","label":"Email","type":"string"},
+					{"name":"closedate","label":"Close Date","type":"datetime"}]}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ListObjectMetadataResult) bool {
+				return mockutils.MetadataResultComparator.SubsetFields(actual, expected)
+			},
+			Expected: &common.ListObjectMetadataResult{
+				Result: map[string]common.ObjectMetadata{
+					"contacts": {
+						DisplayName: "contacts",
+						FieldsMap:   map[string]string{"email": "Email"},
+						FieldTypes: common.FieldTypes{
+							"email":     common.ValueTypeString,
+							"closedate": common.ValueTypeDateTime,
+						},
+					},
+				},
+				Errors: make(map[string]error),
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ObjectMetadataConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestGetRecordCustomObject(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If:    mockcond.PathSuffix("/crm/v3/objects/p1234_cars/901"),
+		Then: mockserver.ResponseString(http.StatusOK,
+			`{"id":"901","properties":{"model":"Roadster","year":"2008"}}`),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create connector: %v", err)
+	}
+
+	record, err := connector.GetRecordWithAssociations(context.Background(), "p1234_cars", "901", nil)
+	if err != nil {
+		t.Fatalf("failed to get record: %v", err)
+	}
+
+	if record.Raw["id"] != "901" {
+		t.Fatalf("expected record 901, got: %v", record.Raw)
+	}
+
+	_, err = connector.GetRecordWithAssociations(context.Background(), "cars", "901", nil)
+	if !errors.Is(err, errGerRecordNotSupportedForObject) {
+		t.Fatalf("expected Error: (%v), got: (%v)", errGerRecordNotSupportedForObject, err)
+	}
+}
+
+func TestCreatePropertyAndGroup(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: []mockserver.Case{{
+			If: mockcond.And{
+				mockcond.MethodPOST(),
+				mockcond.PathSuffix("/crm/v3/properties/p1234_cars/groups"),
+				mockcond.Body(`{"name":"car_information","label":"Car information","displayOrder":1}`),
+			},
+			Then: mockserver.ResponseString(http.StatusCreated,
+				`{"name":"car_information","label":"Car information","displayOrder":1,"archived":false}`),
+		}, {
+			If: mockcond.And{
+				mockcond.MethodPOST(),
+				mockcond.PathSuffix("/crm/v3/properties/p1234_cars"),
+				mockcond.Body(`{"name":"color","label":"Color","type":"enumeration","fieldType":"select",
+					"groupName":"car_information","options":[{"label":"Red","value":"red"}]}`),
+			},
+			Then: mockserver.ResponseString(http.StatusCreated,
+				`{"name":"color","label":"Color","type":"enumeration","fieldType":"select",
+					"groupName":"car_information","options":[{"label":"Red","value":"red","displayOrder":0}]}`),
+		}},
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create connector: %v", err)
+	}
+
+	group, err := connector.CreatePropertyGroup(context.Background(), "p1234_cars", PropertyGroup{
+		Name:         "car_information",
+		Label:        "Car information",
+		DisplayOrder: 1,
+	})
+	if err != nil {
+		t.Fatalf("failed to create property group: %v", err)
+	}
+
+	if group.Name != "car_information" {
+		t.Fatalf("unexpected property group: %v", group)
+	}
+
+	property, err := connector.CreateProperty(context.Background(), "p1234_cars", Property{
+		Name:      "color",
+		Label:     "Color",
+		Type:      "enumeration",
+		FieldType: "select",
+		GroupName: "car_information",
+		Options:   []PropertyOption{{Label: "Red", Value: "red"}},
+	})
+	if err != nil {
+		t.Fatalf("failed to create property: %v", err)
+	}
+
+	if property.Name != "color" || len(property.Options) != 1 {
+		t.Fatalf("unexpected property: %v", property)
+	}
+
+	_, err = connector.CreateProperty(context.Background(), "p1234_cars", Property{Label: "Color"})
+	if !errors.Is(err, ErrMissingPropertyName) {
+		t.Fatalf("expected Error: (%v), got: (%v)", ErrMissingPropertyName, err)
+	}
+}
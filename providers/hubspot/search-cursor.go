@@ -0,0 +1,128 @@
+package hubspot
+
+import (
+	"context"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// searchResultsLimit is the maximum number of records a single search query can page through.
+// Requesting a page beyond it results in 400 Bad Request.
+// Read more @ https://developers.hubspot.com/docs/api/crm/search#limitations
+const searchResultsLimit = 10_000
+
+// searchCursor is the state of incremental search encoded into NextPageToken.
+// Query is restarted with `hs_object_id > LastID` once the search limit is reached.
+type searchCursor struct {
+	// After is the offset within the current query.
+	After string
+	// LastID is the last record ID of previous queries. Empty for the first query.
+	LastID string
+}
+
+// parseSearchCursor decodes NextPageToken.
+// Plain offset is supported as well, it is the format returned by Search.
+func parseSearchCursor(token common.NextPageToken) searchCursor {
+	if !strings.Contains(token.String(), "=") {
+		return searchCursor{After: token.String()}
+	}
+
+	values, err := url.ParseQuery(token.String())
+	if err != nil {
+		return searchCursor{After: token.String()}
+	}
+
+	return searchCursor{
+		After:  values.Get("after"),
+		LastID: values.Get("lastId"),
+	}
+}
+
+func (s searchCursor) token() common.NextPageToken {
+	if len(s.LastID) == 0 {
+		return common.NextPageToken(s.After)
+	}
+
+	values := url.Values{}
+	values.Set("lastId", s.LastID)
+
+	if len(s.After) != 0 {
+		values.Set("after", s.After)
+	}
+
+	return common.NextPageToken(values.Encode())
+}
+
+// searchSince reads records modified since the given time.
+// Records are sorted by ID, so that the query can be restarted from the last ID
+// when the search limit is reached. The caller simply follows NextPage until Done.
+func (c *Connector) searchSince(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
+	cursor := parseSearchCursor(config.NextPage)
+
+	filters := []Filter{
+		BuildLastModifiedFilterGroup(&config),
+	}
+
+	if len(cursor.LastID) != 0 {
+		filters = append(filters, BuildIdFilterGroup(cursor.LastID))
+	}
+
+	result, err := c.Search(ctx, SearchParams{
+		ObjectName: config.ObjectName,
+		FilterGroups: []FilterGroup{{
+			Filters: filters,
+		}},
+		SortBy: []SortBy{
+			BuildSort(ObjectFieldHsObjectId, SortDirectionAsc),
+		},
+		NextPage:          common.NextPageToken(cursor.After),
+		Fields:            config.Fields,
+		AssociatedObjects: config.AssociatedObjects,
+		PreserveFieldCase: config.PreserveFieldCase,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if result.Done {
+		return result, nil
+	}
+
+	next := searchCursor{
+		After:  result.NextPage.String(),
+		LastID: cursor.LastID,
+	}
+
+	if reachesSearchLimit(next.After) {
+		lastID := GetLastResultId(result)
+		if len(lastID) == 0 {
+			// Requesting the next page would be rejected by HubSpot,
+			// and without the last ID the query cannot be restarted.
+			return nil, ErrSearchLimitReached
+		}
+
+		next = searchCursor{LastID: lastID}
+	}
+
+	result.NextPage = next.token()
+
+	return result, nil
+}
+
+// reachesSearchLimit returns true if the next page cannot be requested within the same query.
+func reachesSearchLimit(after string) bool {
+	offset, err := strconv.Atoi(after)
+	if err != nil {
+		return false
+	}
+
+	pageSize, err := strconv.Atoi(DefaultPageSize)
+	if err != nil {
+		return false
+	}
+
+	return offset+pageSize > searchResultsLimit
+}
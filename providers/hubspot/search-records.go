@@ -0,0 +1,133 @@
+package hubspot
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// searchOperators maps provider-neutral operators to HubSpot filter operators.
+var searchOperators = map[common.SearchOperator]FilterOperatorType{ // nolint:gochecknoglobals
+	common.SearchOperatorEquals:             FilterOperatorTypeEQ,
+	common.SearchOperatorNotEquals:          FilterOperatorTypeNEQ,
+	common.SearchOperatorGreaterThan:        FilterOperatorTypeGT,
+	common.SearchOperatorGreaterThanOrEqual: FilterOperatorTypeGTE,
+	common.SearchOperatorLessThan:           FilterOperatorTypeLT,
+	common.SearchOperatorLessThanOrEqual:    FilterOperatorTypeLTE,
+	common.SearchOperatorIn:                 FilterOperatorIN,
+	common.SearchOperatorContains:           FilterPropertyContainsToken,
+}
+
+// SearchRecords implements connectors.SearchConnector using the CRM search endpoint.
+// HubSpot combines filter groups with OR, while filters within a group are combined with AND.
+// Therefore, filter must be a condition, AND of conditions, or OR of conditions and such ANDs.
+// Contains operator matches whole words, use "*" as a wildcard within the value.
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	groups, err := makeSearchFilterGroups(params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	sorts := make([]SortBy, 0, len(params.Sort))
+
+	for _, sort := range params.Sort {
+		direction := SortDirectionAsc
+		if sort.Descending {
+			direction = SortDirectionDesc
+		}
+
+		sorts = append(sorts, SortBy{PropertyName: sort.Field, Direction: direction})
+	}
+
+	return c.Search(ctx, SearchParams{
+		ObjectName:   params.ObjectName,
+		NextPage:     params.NextPage,
+		SortBy:       sorts,
+		FilterGroups: groups,
+		Fields:       params.Fields,
+		Limit:        params.PageSize,
+
+		PreserveFieldCase: params.PreserveFieldCase,
+	})
+}
+
+func makeSearchFilterGroups(filter common.SearchFilter) ([]FilterGroup, error) {
+	if filter.IsEmpty() {
+		return nil, nil
+	}
+
+	if filter.Logic != common.SearchLogicOr {
+		group, err := makeSearchFilterGroup(filter)
+		if err != nil {
+			return nil, err
+		}
+
+		return []FilterGroup{group}, nil
+	}
+
+	groups := make([]FilterGroup, 0, len(filter.Filters))
+
+	for _, nested := range filter.Filters {
+		if nested.Logic == common.SearchLogicOr {
+			return nil, fmt.Errorf("%w: OR cannot be nested under OR", common.ErrUnsupportedSearchFilter)
+		}
+
+		group, err := makeSearchFilterGroup(nested)
+		if err != nil {
+			return nil, err
+		}
+
+		groups = append(groups, group)
+	}
+
+	return groups, nil
+}
+
+// makeSearchFilterGroup converts a condition or AND of conditions.
+func makeSearchFilterGroup(filter common.SearchFilter) (FilterGroup, error) {
+	conditions, ok := filter.Conditions()
+	if !ok || filter.Logic == common.SearchLogicOr {
+		return FilterGroup{}, fmt.Errorf("%w: filter group must be AND of conditions",
+			common.ErrUnsupportedSearchFilter)
+	}
+
+	filters := make([]Filter, 0, len(conditions))
+
+	for _, condition := range conditions {
+		operator, ok := searchOperators[condition.Operator]
+		if !ok {
+			return FilterGroup{}, common.UnsupportedSearchOperator(condition.Operator)
+		}
+
+		converted := Filter{
+			FieldName: condition.Field,
+			Operator:  operator,
+		}
+
+		if operator == FilterOperatorIN {
+			for _, value := range condition.Values() {
+				converted.Values = append(converted.Values, formatSearchValue(value))
+			}
+		} else {
+			converted.Value = formatSearchValue(condition.Value)
+		}
+
+		filters = append(filters, converted)
+	}
+
+	return FilterGroup{Filters: filters}, nil
+}
+
+func formatSearchValue(value any) string {
+	if moment, ok := value.(time.Time); ok {
+		return moment.Format(time.RFC3339)
+	}
+
+	return fmt.Sprint(value)
+}
@@ -0,0 +1,92 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestSearchRecords(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.Search{
+		{
+			Name: "AND cannot hold OR, filter groups are joined by OR only",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Filter: common.SearchAll(
+					common.SearchCondition("lifecyclestage", common.SearchOperatorEquals, "lead"),
+					common.SearchAny(
+						common.SearchCondition("email", common.SearchOperatorEquals, "ada@example.com"),
+						common.SearchCondition("phone", common.SearchOperatorEquals, "42"),
+					),
+				),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedSearchFilter},
+		},
+		{
+			Name: "OR of conditions and ANDs becomes filter groups",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Filter: common.SearchAny(
+					common.SearchCondition("email", common.SearchOperatorEquals, "ada@example.com"),
+					common.SearchAll(
+						common.SearchCondition("firstname", common.SearchOperatorEquals, "Ada"),
+						common.SearchCondition("hs_lead_status", common.SearchOperatorIn, []string{"NEW", "OPEN"}),
+					),
+				),
+				Sort:     []common.SearchSort{{Field: "createdate", Descending: true}},
+				PageSize: 10,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/crm/v3/objects/contacts/search"),
+					mockcond.BodyContains(`"filterGroups":[` +
+						`{"filters":[{"propertyName":"email","operator":"EQ","value":"ada@example.com"}]},` +
+						`{"filters":[{"propertyName":"firstname","operator":"EQ","value":"Ada"},` +
+						`{"propertyName":"hs_lead_status","operator":"IN","values":["NEW","OPEN"]}]}]`),
+					mockcond.BodyContains(`"limit":10`),
+					mockcond.BodyContains(`"sorts":[{"propertyName":"createdate","direction":"DESCENDING"}]`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"total": 1,
+					"results": [{"id": "7001", "properties": {"email": "ada@example.com", "hs_object_id": "7001"}}]
+				}`),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"email": "ada@example.com"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.SearchConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
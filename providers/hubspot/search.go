@@ -31,13 +31,26 @@ func (c *Connector) Search(ctx context.Context, config SearchParams) (*common.Re
 		return nil, err
 	}
 
-	return common.ParseResult(
+	result, err := common.ParseResult(
 		rsp,
 		getRecords,
 		getNextRecordsAfter,
 		getMarshalledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
+	if err != nil {
+		return nil, err
+	}
+
+	// Search endpoint doesn't return associations, they are requested separately.
+	if len(config.AssociatedObjects) != 0 {
+		if err = c.fetchAssociations(ctx, config.ObjectName, result.Data, config.AssociatedObjects); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
 }
 
 // BuildLastModifiedFilterGroup filters records modified since the given time.
@@ -86,6 +99,10 @@ func makeFilterBody(config SearchParams) map[string]any {
 		"limit": DefaultPageSize,
 	}
 
+	if config.Limit != 0 {
+		filterBody["limit"] = config.Limit
+	}
+
 	if config.FilterGroups != nil {
 		filterBody["filterGroups"] = config.FilterGroups
 	}
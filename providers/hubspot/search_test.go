@@ -0,0 +1,164 @@
+package hubspot
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestReadSinceBeyondSearchLimit(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	since := time.Date(2024, 10, 1, 0, 0, 0, 0, time.UTC)
+	sinceFilter := `{"propertyName":"lastmodifieddate","operator":"GTE","value":"2024-10-01T00:00:00Z"}`
+
+	pageResponse := func(after string) string {
+		return `{"total":20000,"results":[` +
+			`{"id":"7001","properties":{"hs_object_id":"7001"}},` +
+			`{"id":"7002","properties":{"hs_object_id":"7002"}}` +
+			`],"paging":{"next":{"after":"` + after + `"}}}`
+	}
+
+	nextPageComparator := func(serverURL string, actual, expected *common.ReadResult) bool {
+		return actual.Rows == expected.Rows &&
+			actual.NextPage == expected.NextPage &&
+			actual.Done == expected.Done
+	}
+
+	tests := []testroutines.Read{
+		{
+			Name: "Next page offset is kept within search limit",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      since,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/crm/v3/objects/contacts/search"),
+					mockcond.BodyContains(`"filterGroups":[{"filters":[` + sinceFilter + `]}]`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, pageResponse("100")),
+			}.Server(),
+			Comparator: nextPageComparator,
+			Expected: &common.ReadResult{
+				Rows:     2,
+				NextPage: "100",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Search is restarted after last ID when limit is reached",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      since,
+				NextPage:   "9900",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/crm/v3/objects/contacts/search"),
+					mockcond.BodyContains(`"after":"9900"`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, pageResponse("10000")),
+			}.Server(),
+			Comparator: nextPageComparator,
+			Expected: &common.ReadResult{
+				Rows:     2,
+				NextPage: "lastId=7002",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Search limit without last record ID is an error",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      since,
+				NextPage:   "9900",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.BodyContains(`"after":"9900"`),
+				Then: mockserver.ResponseString(http.StatusOK, `{"total":20000,"results":[
+					{"properties":{"email":"ada@example.com"}}
+				],"paging":{"next":{"after":"10000"}}}`),
+			}.Server(),
+			ExpectedErrs: []error{ErrSearchLimitReached},
+		},
+		{
+			Name: "Restarted search filters by last ID and keeps paging",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      since,
+				NextPage:   "lastId=7002",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/crm/v3/objects/contacts/search"),
+					mockcond.BodyContains(`"filterGroups":[{"filters":[` + sinceFilter +
+						`,{"propertyName":"hs_object_id","operator":"GT","value":"7002"}]}]`),
+					mockcond.BodyContains(`"sorts":[{"propertyName":"hs_object_id","direction":"ASCENDING"}]`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, pageResponse("100")),
+			}.Server(),
+			Comparator: nextPageComparator,
+			Expected: &common.ReadResult{
+				Rows:     2,
+				NextPage: "after=100&lastId=7002",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Last page of restarted search is done",
+			Input: common.ReadParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("email"),
+				Since:      since,
+				NextPage:   "after=100&lastId=7002",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.BodyContains(`"after":"100"`),
+					mockcond.BodyContains(`{"propertyName":"hs_object_id","operator":"GT","value":"7002"}`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{"total":1,"results":[
+					{"id":"9001","properties":{"hs_object_id":"9001"}}
+				]}`),
+			}.Server(),
+			Comparator: nextPageComparator,
+			Expected: &common.ReadResult{
+				Rows:     1,
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
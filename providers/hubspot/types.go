@@ -16,6 +16,12 @@ type SearchParams struct {
 	FilterGroups []FilterGroup // optional
 	// Fields is the list of fields to return in the result.
 	Fields datautils.Set[string] // optional
+	// AssociatedObjects are fetched for every found record using Associations API, e.g. ["companies"].
+	AssociatedObjects []string // optional
+	// Limit is the number of records per page, DefaultPageSize is used when omitted.
+	Limit int // optional
+	// PreserveFieldCase keys ReadResultRow.Fields exactly as fields were requested.
+	PreserveFieldCase bool // optional
 }
 
 func (p SearchParams) ValidateParams() error {
@@ -45,6 +51,8 @@ type Filter struct {
 	FieldName string             `json:"propertyName,omitempty"`
 	Operator  FilterOperatorType `json:"operator,omitempty"`
 	Value     string             `json:"value,omitempty"`
+	// Values are used by IN and NIN operators.
+	Values []string `json:"values,omitempty"`
 }
 
 type (
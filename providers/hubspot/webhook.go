@@ -37,7 +37,7 @@ func (c *Connector) GetRecordFromWebhookMessage(
 	recordId := strconv.Itoa(msg.ObjectId)
 
 	// Since the webhook message doesn't contain the record data, we need to fetch it.
-	return c.GetRecord(ctx, objectName, recordId)
+	return c.GetRecordWithAssociations(ctx, objectName, recordId, nil)
 }
 
 var errUnexpectedWebhookEventType = errors.New("unexpected webhook event type")
@@ -23,7 +23,19 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 		return nil, err
 	}
 
-	var write common.WriteMethod
+	var (
+		write        common.WriteMethod
+		associations []Association
+		err          error
+	)
+
+	// Associations are validated before the record is written.
+	if config.Associations != nil {
+		associations, err = parseAssociations(config.Associations, config.RecordId != "")
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	relativeURL := path.Join("objects", config.ObjectName)
 	url := c.getURL(relativeURL)
@@ -41,6 +53,11 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 	data := make(map[string]interface{})
 	data["properties"] = config.RecordData
 
+	// Associations can be set in the same request only when creating a record.
+	if config.RecordId == "" && associations != nil {
+		data["associations"] = associations
+	}
+
 	json, err := write(ctx, url, data)
 	if err != nil {
 		return nil, err
@@ -51,6 +68,12 @@ func (c *Connector) Write(ctx context.Context, config common.WriteParams) (*comm
 		return nil, err
 	}
 
+	if config.RecordId != "" && associations != nil {
+		if err = c.associateRecord(ctx, config.ObjectName, config.RecordId, associations); err != nil {
+			return nil, err
+		}
+	}
+
 	return &common.WriteResult{
 		RecordId: rsp.ID,
 		Success:  true,
@@ -0,0 +1,20 @@
+package instantly
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Capabilities describes supported objects. Instantly has no time filtering, every read is a full read.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return common.NewCapabilities().
+		WithRead(common.ReadCapabilities{
+			Pagination:  common.PaginationOffset,
+			MaxPageSize: DefaultPageSize,
+		}, supportedObjectsByRead.List()...).
+		WithOperation(common.OperationCreate, supportedObjectsByWrite.List()...).
+		// Only tags have update endpoint, see constructURLPathUpdate.
+		WithOperation(common.OperationUpdate, objectNameTags).
+		WithOperation(common.OperationDelete, supportedObjectsByDelete.List()...), nil
+}
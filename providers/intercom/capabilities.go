@@ -0,0 +1,23 @@
+package intercom
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/intercom/metadata"
+)
+
+// Capabilities lists objects of the static schema. Write operations come from endpoints of the Intercom
+// OpenAPI file, ex: contacts support all of them, events are only created, while admins, teams or segments
+// are read only.
+// Incremental reading is done via search, by filtering listed companies, or by activity log creation time.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return metadata.Schemas.Capabilities(c.Module.ID, func(objectName string) common.ReadCapabilities {
+		return common.ReadCapabilities{
+			Incremental: searchObjectsPageSize.Has(objectName) ||
+				objectName == companiesObjectName || objectName == "activity_logs",
+			Pagination:  common.PaginationCursor,
+			MaxPageSize: searchObjectsPageSize.Get(objectName),
+		}
+	}, common.OperationCreate, common.OperationUpdate, common.OperationDelete), nil
+}
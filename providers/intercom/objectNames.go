@@ -9,6 +9,9 @@ import (
 // Full read and incremental read are done for tickets using POST search.
 const ticketsObjectName = "tickets"
 
+// Companies cannot be searched, incremental read filters listed companies by update time.
+const companiesObjectName = "companies"
+
 // Supported object names can be found under schemas.json.
 var supportedObjectsByRead = metadata.Schemas.ObjectNames() //nolint:gochecknoglobals
 
@@ -39,8 +42,9 @@ var objectNameToURLPath = datautils.NewDefaultMap(map[string]string{ //nolint:go
 	return obj
 })
 
+// searchObjectsPageSize lists objects which can be searched, along with the largest page size.
 // nolint:gomnd
-var incrementalSearchObjectPagination = datautils.NewDefaultMap(map[string]int{ //nolint:gochecknoglobals
+var searchObjectsPageSize = datautils.NewDefaultMap(map[string]int{ //nolint:gochecknoglobals
 	// https://developers.intercom.com/docs/references/rest-api/api.intercom.io/conversations/searchconversations
 	"conversations": 150,
 	// https://developers.intercom.com/docs/references/rest-api/api.intercom.io/contacts/searchcontacts
@@ -3,6 +3,7 @@ package intercom
 import (
 	"errors"
 	"strings"
+	"time"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/jsonquery"
@@ -46,6 +47,27 @@ func getRecords(node *ajson.Node) ([]map[string]any, error) {
 	return jsonquery.Convertor.ArrayToMap(arr)
 }
 
+// makeUpdatedSinceRecords keeps records with `updated_at` unix time after the given moment.
+func makeUpdatedSinceRecords(since time.Time) common.RecordsFunc {
+	return func(node *ajson.Node) ([]map[string]any, error) {
+		records, err := getRecords(node)
+		if err != nil {
+			return nil, err
+		}
+
+		filtered := make([]map[string]any, 0, len(records))
+
+		for _, record := range records {
+			updatedAt, ok := record["updated_at"].(float64)
+			if !ok || int64(updatedAt) > since.Unix() {
+				filtered = append(filtered, record)
+			}
+		}
+
+		return filtered, nil
+	}
+}
+
 func makeNextRecordsURL(reqLink *urlbuilder.URL) common.NextPageFunc {
 	return func(node *ajson.Node) (string, error) {
 		next, err := getNextPageStringURL(node)
@@ -9,6 +9,9 @@ import (
 	"github.com/amp-labs/connectors/common/urlbuilder"
 )
 
+// Read lists records of the object.
+// Contacts, conversations and tickets are searched when `Since` is provided, tickets are always searched.
+// Companies have no search, listed companies are filtered by update time instead.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	if err := config.ValidateParams(true); err != nil {
 		return nil, err
@@ -18,44 +21,41 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		return nil, common.ErrOperationNotSupportedForObject
 	}
 
-	rsp, url, err := c.performReadQuery(ctx, config)
-	if err != nil {
-		return nil, err
-	}
-
-	return common.ParseResult(
-		rsp,
-		getRecords,
-		makeNextRecordsURL(url),
-		common.GetMarshaledData,
-		config.Fields,
-	)
-}
-
-// There are 2 choices. Default usage of GET.
-// Or we can do POST for conversations scoping by `Since` time.
-func (c *Connector) performReadQuery(
-	ctx context.Context, config common.ReadParams,
-) (*common.JSONHTTPResponse, *urlbuilder.URL, error) {
-	if rsp, url, err, searchable := c.readViaSearch(ctx, config); searchable {
-		return rsp, url, err
+	if result, searchable, err := c.readViaSearch(ctx, config); searchable {
+		return result, err
 	}
 
 	// Default.
 	// READ is done the usual way via GET, listing object.
 	url, err := c.buildReadURL(config)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
 	}
 
 	url = enhanceReadWithQueryParams(url, config.ObjectName, config.Since)
 
 	rsp, err := c.Client.Get(ctx, url.String(), apiVersionHeader)
 	if err != nil {
-		return nil, nil, err
+		return nil, err
+	}
+
+	records := getRecords
+	options := []common.ParseOption{common.WithPreservedFieldCase(config.PreserveFieldCase)}
+
+	if config.ObjectName == companiesObjectName && !config.Since.IsZero() {
+		// Page of older companies is empty, yet next pages may have recent ones.
+		records = makeUpdatedSinceRecords(config.Since)
+		options = append(options, common.WithClientSideFilter())
 	}
 
-	return rsp, url, nil
+	return common.ParseResult(
+		rsp,
+		records,
+		makeNextRecordsURL(url),
+		common.GetMarshaledData,
+		config.Fields,
+		options...,
+	)
 }
 
 func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, error) {
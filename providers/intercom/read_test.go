@@ -295,6 +295,32 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Page of companies older than since is empty yet not the last",
+			Input: common.ReadParams{
+				ObjectName: "companies",
+				Fields:     connectors.Fields("id"),
+				Since:      time.Unix(1726674883, 0),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/companies"),
+				Then: mockserver.ResponseString(http.StatusOK, `{"type": "list",
+					"data": [{"type": "company", "id": "6", "updated_at": 1726000000}],
+					"pages": {"type": "pages", "next": {"starting_after": "WzE3MjYwMDAwMDAsNl0="}}}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return actual.Rows == expected.Rows &&
+					strings.Contains(actual.NextPage.String(), "starting_after=") &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 0,
+				Data: []common.ReadResultRow{},
+				Done: false,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -0,0 +1,123 @@
+package intercom
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// searchOperators maps provider-neutral operators to Intercom operators.
+// Intercom has no inclusive range operators, they are expressed as OR with equality.
+var searchOperators = map[common.SearchOperator]SearchOperator{ // nolint:gochecknoglobals
+	common.SearchOperatorEquals:      SearchOperatorEQ,
+	common.SearchOperatorNotEquals:   SearchOperatorNEQ,
+	common.SearchOperatorGreaterThan: SearchOperatorGT,
+	common.SearchOperatorLessThan:    SearchOperatorLT,
+	common.SearchOperatorIn:          SearchOperatorIN,
+	common.SearchOperatorContains:    SearchOperatorContains,
+}
+
+// SearchRecords implements connectors.SearchConnector for contacts, conversations and tickets.
+// Intercom sorts by a single field. Empty filter matches records updated since the beginning of time.
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if len(params.Sort) > 1 {
+		return nil, fmt.Errorf("%w: results are sorted by one field", common.ErrUnsupportedSearchFilter)
+	}
+
+	query := SearchCondition("updated_at", SearchOperatorGT, 0)
+
+	if !params.Filter.IsEmpty() {
+		var err error
+
+		query, err = makeSearchQuery(params.Filter)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var sort *SearchSort
+
+	for _, by := range params.Sort {
+		sort = &SearchSort{Field: by.Field, Order: SearchSortAscending}
+		if by.Descending {
+			sort.Order = SearchSortDescending
+		}
+	}
+
+	return c.Search(ctx, SearchParams{
+		ObjectName: params.ObjectName,
+		Query:      query,
+		Sort:       sort,
+		Fields:     params.Fields,
+		NextPage:   params.NextPage,
+		PageSize:   params.PageSize,
+
+		PreserveFieldCase: params.PreserveFieldCase,
+	})
+}
+
+func makeSearchQuery(filter common.SearchFilter) (SearchQuery, error) {
+	if filter.IsCompound() {
+		queries := make([]SearchQuery, 0, len(filter.Filters))
+
+		for _, nested := range filter.Filters {
+			query, err := makeSearchQuery(nested)
+			if err != nil {
+				return SearchQuery{}, err
+			}
+
+			queries = append(queries, query)
+		}
+
+		if filter.Logic == common.SearchLogicOr {
+			return SearchOr(queries...), nil
+		}
+
+		return SearchAnd(queries...), nil
+	}
+
+	value := searchValue(filter.Value)
+	if filter.Operator == common.SearchOperatorIn {
+		values := filter.Values()
+		for index := range values {
+			values[index] = searchValue(values[index])
+		}
+
+		value = values
+	}
+
+	switch filter.Operator {
+	case common.SearchOperatorGreaterThanOrEqual:
+		return SearchOr(
+			SearchCondition(filter.Field, SearchOperatorGT, value),
+			SearchCondition(filter.Field, SearchOperatorEQ, value),
+		), nil
+	case common.SearchOperatorLessThanOrEqual:
+		return SearchOr(
+			SearchCondition(filter.Field, SearchOperatorLT, value),
+			SearchCondition(filter.Field, SearchOperatorEQ, value),
+		), nil
+	}
+
+	operator, ok := searchOperators[filter.Operator]
+	if !ok {
+		return SearchQuery{}, common.UnsupportedSearchOperator(filter.Operator)
+	}
+
+	return SearchCondition(filter.Field, operator, value), nil
+}
+
+// searchValue converts timestamps to Unix time used by Intercom.
+func searchValue(value any) any {
+	if moment, ok := value.(time.Time); ok {
+		return moment.Unix()
+	}
+
+	return value
+}
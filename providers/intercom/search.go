@@ -2,115 +2,289 @@ package intercom
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
 	"strconv"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/datautils"
 )
 
-// Search is used when `Since` parameter is provided for the select few objects.
-// Documentation below explains how search is done for "conversations" object. Others, use the same query language.
-// https://developers.intercom.com/docs/references/rest-api/api.intercom.io/conversations/searchconversations
+var (
+	ErrSearchNotSupported = errors.New("object cannot be searched")
+	ErrSearchQueryTooDeep = errors.New("search query nests compound queries too deep")
+	ErrSearchQueryTooWide = errors.New("search query combines too many conditions")
+	ErrMissingSearchField = errors.New("search condition must have a field")
+)
+
+const (
+	// searchMaxNesting is the number of compound levels allowed above conditions.
+	searchMaxNesting = 2
+	// searchMaxConditions is the number of queries allowed in a single compound.
+	searchMaxConditions = 15
+)
+
+// SearchParams describes search of contacts, conversations or tickets.
+// The query language is shared by all searchable objects, fields differ.
 // https://developers.intercom.com/docs/references/rest-api/api.intercom.io/contacts/searchcontacts
-func (c *Connector) readViaSearch(
-	ctx context.Context, config common.ReadParams,
-) (*common.JSONHTTPResponse, *urlbuilder.URL, error, bool) {
-	if !incrementalSearchObjectPagination.Has(config.ObjectName) {
-		return nil, nil, nil, false
+// https://developers.intercom.com/docs/references/rest-api/api.intercom.io/conversations/searchconversations
+// https://developers.intercom.com/docs/references/rest-api/api.intercom.io/tickets/searchtickets
+type SearchParams struct {
+	// ObjectName is one of "contacts", "conversations", "tickets".
+	ObjectName string // required
+	// Query selects records, use SearchCondition, SearchAnd and SearchOr to build it.
+	Query SearchQuery // required
+	// Sort orders the results, Intercom default is used when omitted.
+	Sort *SearchSort // optional
+	// Fields is the list of fields to return in the result.
+	Fields datautils.StringSet // required
+	// NextPage is the token returned by the previous search with the same query.
+	NextPage common.NextPageToken // optional
+	// PageSize overrides the number of records per page, limited by Intercom to 150.
+	PageSize int // optional
+	// PreserveFieldCase keys ReadResultRow.Fields exactly as fields were requested.
+	PreserveFieldCase bool // optional
+}
+
+func (p SearchParams) ValidateParams() error {
+	if len(p.ObjectName) == 0 {
+		return common.ErrMissingObjects
 	}
 
-	if config.Since.IsZero() && config.ObjectName != ticketsObjectName {
-		// Search is only relevant when we do incremental reading.
-		// Tickets is an exception. We can do full read only via POST.
-		return nil, nil, nil, false
+	if !searchObjectsPageSize.Has(p.ObjectName) {
+		return fmt.Errorf("%w: %s", ErrSearchNotSupported, p.ObjectName)
 	}
 
-	url, err := constructURL(c.BaseURL, config.ObjectName, "search")
-	if err != nil {
-		return nil, nil, err, true
+	if len(p.Fields) == 0 {
+		return common.ErrMissingFields
 	}
 
-	conversation, err := c.createSearchPayload(config)
-	if err != nil {
-		return nil, nil, err, true
+	return p.Query.validate(0)
+}
+
+// SearchOperator compares field with the value, or combines nested queries.
+type SearchOperator string
+
+const (
+	SearchOperatorAND SearchOperator = "AND"
+	SearchOperatorOR  SearchOperator = "OR"
+
+	SearchOperatorEQ          SearchOperator = "="
+	SearchOperatorNEQ         SearchOperator = "!="
+	SearchOperatorIN          SearchOperator = "IN"
+	SearchOperatorNIN         SearchOperator = "NIN"
+	SearchOperatorLT          SearchOperator = "<"
+	SearchOperatorGT          SearchOperator = ">"
+	SearchOperatorContains    SearchOperator = "~"
+	SearchOperatorNotContains SearchOperator = "!~"
+	SearchOperatorStartsWith  SearchOperator = "^"
+	SearchOperatorEndsWith    SearchOperator = "$"
+)
+
+// SearchQuery is either a field condition or a compound of nested queries joined by AND/OR.
+type SearchQuery struct {
+	Field    string
+	Operator SearchOperator
+	// Value is compared with the field, ex: string, number, boolean, or a list for IN operators.
+	Value any
+	// Queries are nested under AND/OR operator.
+	Queries []SearchQuery
+}
+
+// SearchCondition compares record field with the value.
+func SearchCondition(field string, operator SearchOperator, value any) SearchQuery {
+	return SearchQuery{
+		Field:    field,
+		Operator: operator,
+		Value:    value,
 	}
+}
 
-	rsp, err := c.Client.Post(ctx, url.String(), &conversation, apiVersionHeader)
-	if err != nil {
-		return nil, nil, err, true
-	}
-
-	return rsp, url, nil, true
-}
-
-func (c *Connector) createSearchPayload(params common.ReadParams) (*searchReqPayload, error) {
-	if params.Since.IsZero() && params.ObjectName == ticketsObjectName {
-		// Perform full read for tickets using POST query.
-		// This is a hack, query is designed to return all objects.
-		return &searchReqPayload{
-			Query: searchQuery{
-				Operator: "OR",
-				Value: []searchQueryValue{{
-					Field:    "open",
-					Operator: "=",
-					Value:    "true",
-				}, {
-					Field:    "open",
-					Operator: "=",
-					Value:    "false",
-				}},
-			},
-			Pagination: searchPagination{
-				PerPage: incrementalSearchObjectPagination.Get(params.ObjectName),
-			},
-		}, nil
-	}
-
-	url, err := urlbuilder.New(params.NextPage.String())
-	if err != nil {
-		return nil, err
+// SearchAnd matches records satisfying every query.
+func SearchAnd(queries ...SearchQuery) SearchQuery {
+	return SearchQuery{
+		Operator: SearchOperatorAND,
+		Queries:  queries,
 	}
+}
 
-	// Unix time format is used.
-	updatedAfter := strconv.FormatInt(params.Since.Unix(), 10)
-	// We no longer request by GET, so query parameter must be moved to the POST payload.
-	startingAfter, _ := url.GetFirstQueryParam("starting_after")
+// SearchOr matches records satisfying any query.
+func SearchOr(queries ...SearchQuery) SearchQuery {
+	return SearchQuery{
+		Operator: SearchOperatorOR,
+		Queries:  queries,
+	}
+}
 
-	conversation := searchReqPayload{
-		Query: searchQuery{
-			Operator: "AND",
-			Value: []searchQueryValue{{
-				Field:    "updated_at",
-				Operator: ">",
-				Value:    updatedAfter,
-			}},
-		},
-		Pagination: searchPagination{
-			PerPage:       incrementalSearchObjectPagination.Get(params.ObjectName),
-			StartingAfter: startingAfter,
-		},
+func (q SearchQuery) isCompound() bool {
+	return q.Operator == SearchOperatorAND || q.Operator == SearchOperatorOR
+}
+
+func (q SearchQuery) validate(depth int) error {
+	if !q.isCompound() {
+		if len(q.Field) == 0 {
+			return ErrMissingSearchField
+		}
+
+		return nil
+	}
+
+	if depth >= searchMaxNesting {
+		return ErrSearchQueryTooDeep
 	}
 
-	return &conversation, nil
+	if len(q.Queries) > searchMaxConditions {
+		return fmt.Errorf("%w: %v out of %v", ErrSearchQueryTooWide, len(q.Queries), searchMaxConditions)
+	}
+
+	for _, query := range q.Queries {
+		if err := query.validate(depth + 1); err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
-type searchReqPayload struct {
-	Query      searchQuery      `json:"query"`
-	Pagination searchPagination `json:"pagination"`
+// MarshalJSON produces the Intercom format where nested queries are placed under "value".
+func (q SearchQuery) MarshalJSON() ([]byte, error) {
+	if q.isCompound() {
+		return json.Marshal(searchCompound{
+			Operator: q.Operator,
+			Value:    q.Queries,
+		})
+	}
+
+	return json.Marshal(searchCondition{
+		Field:    q.Field,
+		Operator: q.Operator,
+		Value:    q.Value,
+	})
 }
 
-type searchQuery struct {
-	Operator string             `json:"operator"`
-	Value    []searchQueryValue `json:"value"`
+type searchCompound struct {
+	Operator SearchOperator `json:"operator"`
+	Value    []SearchQuery  `json:"value"`
 }
 
-type searchQueryValue struct {
-	Field    string `json:"field"`
-	Operator string `json:"operator"`
-	Value    string `json:"value"`
+type searchCondition struct {
+	Field    string         `json:"field"`
+	Operator SearchOperator `json:"operator"`
+	Value    any            `json:"value"`
+}
+
+type SearchSortOrder string
+
+const (
+	SearchSortAscending  SearchSortOrder = "ascending"
+	SearchSortDescending SearchSortOrder = "descending"
+)
+
+type SearchSort struct {
+	Field string          `json:"field"`
+	Order SearchSortOrder `json:"order"`
+}
+
+type searchReqPayload struct {
+	Query      SearchQuery      `json:"query"`
+	Sort       *SearchSort      `json:"sort,omitempty"`
+	Pagination searchPagination `json:"pagination"`
 }
 
 type searchPagination struct {
 	PerPage       int    `json:"per_page"`                 //nolint:tagliatelle
 	StartingAfter string `json:"starting_after,omitempty"` //nolint:tagliatelle
 }
+
+// Search uses the POST /{object}/search endpoint to find records matching the query.
+// Pagination is done by cursor, NextPage is a URL holding the cursor as "starting_after" query parameter.
+func (c *Connector) Search(ctx context.Context, params SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	url, err := constructURL(c.BaseURL, params.ObjectName, "search")
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := createSearchPayload(params)
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Post(ctx, url.String(), payload, apiVersionHeader)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		getRecords,
+		makeNextRecordsURL(url),
+		common.GetMarshaledData,
+		params.Fields,
+		common.WithPreservedFieldCase(params.PreserveFieldCase),
+	)
+}
+
+func createSearchPayload(params SearchParams) (*searchReqPayload, error) {
+	pageSize := params.PageSize
+	if pageSize == 0 {
+		pageSize = searchObjectsPageSize.Get(params.ObjectName)
+	}
+
+	// The query parameter of the previous page is moved to the POST payload.
+	var startingAfter string
+
+	if len(params.NextPage) != 0 {
+		url, err := urlbuilder.New(params.NextPage.String())
+		if err != nil {
+			return nil, err
+		}
+
+		startingAfter, _ = url.GetFirstQueryParam("starting_after")
+	}
+
+	return &searchReqPayload{
+		Query: params.Query,
+		Sort:  params.Sort,
+		Pagination: searchPagination{
+			PerPage:       pageSize,
+			StartingAfter: startingAfter,
+		},
+	}, nil
+}
+
+// readViaSearch is used when `Since` parameter is provided for searchable objects.
+// Tickets cannot be listed using GET, full read searches for tickets updated since the beginning of time.
+func (c *Connector) readViaSearch(ctx context.Context, config common.ReadParams) (*common.ReadResult, bool, error) {
+	if !searchObjectsPageSize.Has(config.ObjectName) {
+		return nil, false, nil
+	}
+
+	if config.Since.IsZero() && config.ObjectName != ticketsObjectName {
+		return nil, false, nil
+	}
+
+	var updatedAfter int64
+	if !config.Since.IsZero() {
+		// Unix time format is used.
+		updatedAfter = config.Since.Unix()
+	}
+
+	result, err := c.Search(ctx, SearchParams{
+		ObjectName: config.ObjectName,
+		Query: SearchAnd(
+			SearchCondition("updated_at", SearchOperatorGT, strconv.FormatInt(updatedAfter, 10)),
+		),
+		Fields:   config.Fields,
+		NextPage: config.NextPage,
+
+		PreserveFieldCase: config.PreserveFieldCase,
+	})
+
+	return result, true, err
+}
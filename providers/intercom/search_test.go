@@ -0,0 +1,292 @@
+package intercom
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestSearch(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	fields := datautils.NewStringSet("id", "email")
+
+	tests := []struct {
+		name         string
+		input        SearchParams
+		server       *httptest.Server
+		expected     *common.ReadResult
+		expectedErrs []error
+	}{
+		{
+			name:         "Companies cannot be searched",
+			input:        SearchParams{ObjectName: "companies", Fields: fields},
+			server:       mockserver.Dummy(),
+			expectedErrs: []error{ErrSearchNotSupported},
+		},
+		{
+			name: "Compound queries are limited to two levels",
+			input: SearchParams{
+				ObjectName: "contacts",
+				Fields:     fields,
+				Query: SearchAnd(SearchOr(SearchAnd(
+					SearchCondition("role", SearchOperatorEQ, "user"),
+				))),
+			},
+			server:       mockserver.Dummy(),
+			expectedErrs: []error{ErrSearchQueryTooDeep},
+		},
+		{
+			name: "Nested query, sort and cursor are sent in the payload",
+			input: SearchParams{
+				ObjectName: "contacts",
+				Fields:     fields,
+				Query: SearchAnd(
+					SearchCondition("role", SearchOperatorEQ, "user"),
+					SearchOr(
+						SearchCondition("email", SearchOperatorEndsWith, "@withampersand.com"),
+						SearchCondition("custom_attributes.plan", SearchOperatorIN, []string{"pro", "team"}),
+					),
+				),
+				Sort:     &SearchSort{Field: "updated_at", Order: SearchSortAscending},
+				NextPage: "https://api.intercom.io/contacts/search?starting_after=WzE3MjY3NTIxNDUwMDAsNSwyXQ==",
+				PageSize: 20,
+			},
+			server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/contacts/search"),
+					mockcond.Header(testApiVersionHeader),
+					mockcond.Body(`{
+						"query": {"operator": "AND", "value": [
+							{"field": "role", "operator": "=", "value": "user"},
+							{"operator": "OR", "value": [
+								{"field": "email", "operator": "$", "value": "@withampersand.com"},
+								{"field": "custom_attributes.plan", "operator": "IN", "value": ["pro", "team"]}
+							]}
+						]},
+						"sort": {"field": "updated_at", "order": "ascending"},
+						"pagination": {"per_page": 20, "starting_after": "WzE3MjY3NTIxNDUwMDAsNSwyXQ=="}
+					}`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"type": "list",
+					"data": [{"type": "contact", "id": "66f2", "email": "ada@withampersand.com"}],
+					"total_count": 21,
+					"pages": {"type": "pages", "page": 2, "per_page": 20, "total_pages": 2}
+				}`),
+			}.Server(),
+			expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "66f2", "email": "ada@withampersand.com"},
+				}},
+				Done: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			defer tt.server.Close()
+
+			connector, err := constructTestConnector(tt.server.URL)
+			if err != nil {
+				t.Fatalf("failed to construct connector: %v", err)
+			}
+
+			result, err := connector.Search(context.Background(), tt.input)
+
+			for _, expectedErr := range tt.expectedErrs {
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("expected error: (%v), got: (%v)", expectedErr, err)
+				}
+			}
+
+			if len(tt.expectedErrs) == 0 {
+				if err != nil {
+					t.Fatalf("unexpected error: %v", err)
+				}
+
+				if !mockutils.ReadResultComparator.SubsetFields(result, tt.expected) ||
+					result.Rows != tt.expected.Rows || result.Done != tt.expected.Done {
+					t.Fatalf("expected: (%v), got: (%v)", tt.expected, result)
+				}
+			}
+		})
+	}
+}
+
+func TestReadSearchableObjects(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.Read{
+		{
+			Name:  "Full read of tickets searches since the beginning of time",
+			Input: common.ReadParams{ObjectName: "tickets", Fields: connectors.Fields("id")},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/tickets/search"),
+					mockcond.Body(`{
+						"query": {"operator": "AND", "value": [{"field": "updated_at", "operator": ">", "value": "0"}]},
+						"pagination": {"per_page": 150}
+					}`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"type": "ticket.list",
+					"tickets": [{"type": "ticket", "id": "17"}],
+					"pages": {"type": "pages", "page": 1, "per_page": 150, "total_pages": 1}
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "17"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Incremental read of companies filters the list by update time",
+			Input: common.ReadParams{
+				ObjectName: "companies",
+				Fields:     connectors.Fields("name"),
+				Since:      time.Unix(1726674883, 0),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/companies"),
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"type": "list",
+					"data": [
+						{"type": "company", "id": "1", "name": "Stale", "updated_at": 1726600000},
+						{"type": "company", "id": "2", "name": "Fresh", "updated_at": 1726752145}
+					],
+					"pages": {"type": "pages", "page": 1, "per_page": 50, "total_pages": 1}
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Rows == expected.Rows
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"name": "Fresh"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestSearchRecords(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.Search{
+		{
+			Name: "Results are sorted by one field",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("id"),
+				Sort:       []common.SearchSort{{Field: "name"}, {Field: "email"}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedSearchFilter},
+		},
+		{
+			Name: "Inclusive range is expressed with equality, time is sent as Unix time",
+			Input: common.SearchParams{
+				ObjectName: "conversations",
+				Fields:     connectors.Fields("id"),
+				Filter: common.SearchAll(
+					common.SearchCondition("state", common.SearchOperatorIn, []string{"open", "snoozed"}),
+					common.SearchCondition("created_at",
+						common.SearchOperatorGreaterThanOrEqual, time.Unix(1726674883, 0)),
+				),
+				Sort: []common.SearchSort{{Field: "created_at", Descending: true}},
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/conversations/search"),
+					mockcond.Body(`{
+						"query": {"operator": "AND", "value": [
+							{"field": "state", "operator": "IN", "value": ["open", "snoozed"]},
+							{"operator": "OR", "value": [
+								{"field": "created_at", "operator": ">", "value": 1726674883},
+								{"field": "created_at", "operator": "=", "value": 1726674883}
+							]}
+						]},
+						"sort": {"field": "created_at", "order": "descending"},
+						"pagination": {"per_page": 150}
+					}`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"type": "conversation.list",
+					"conversations": [{"type": "conversation", "id": "1911"}],
+					"pages": {"type": "pages", "page": 1, "per_page": 150, "total_pages": 1}
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "1911"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.SearchConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
@@ -0,0 +1,27 @@
+package keap
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/keap/metadata"
+)
+
+// Capabilities is derived from the static schema, the connector is read only.
+// Since is supported by the version 1 API, which returns the URL of the next page.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return metadata.Schemas.Capabilities(c.Module.ID, func(string) common.ReadCapabilities {
+		if c.Module.ID == ModuleV1 {
+			return common.ReadCapabilities{
+				Incremental: true,
+				Pagination:  common.PaginationNextURL,
+				MaxPageSize: DefaultPageSize,
+			}
+		}
+
+		return common.ReadCapabilities{
+			Pagination:  common.PaginationCursor,
+			MaxPageSize: DefaultPageSize,
+		}
+	}), nil
+}
@@ -36,6 +36,7 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		makeNextRecordsURL(c.Module.ID),
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
 
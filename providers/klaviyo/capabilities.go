@@ -0,0 +1,30 @@
+package klaviyo
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Capabilities describes objects of the current module.
+// Incremental reading is possible for objects with a known timestamp field, see objectsNameToSinceFieldName.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	capabilities := common.NewCapabilities()
+
+	for _, objectName := range supportedObjectsByRead[c.Module.ID].List() {
+		_, incremental := objectsNameToSinceFieldName[c.Module.ID][objectName]
+
+		capabilities.WithRead(common.ReadCapabilities{
+			Incremental: incremental,
+			Filter:      true,
+			Pagination:  common.PaginationNextURL,
+		}, objectName)
+	}
+
+	capabilities.
+		WithOperation(common.OperationCreate, supportedObjectsByCreate[c.Module.ID].List()...).
+		WithOperation(common.OperationUpdate, supportedObjectsByUpdate[c.Module.ID].List()...).
+		WithOperation(common.OperationDelete, supportedObjectsByDelete[c.Module.ID].List()...)
+
+	return capabilities, nil
+}
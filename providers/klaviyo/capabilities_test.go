@@ -0,0 +1,56 @@
+package klaviyo
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Dummy()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	capabilities, err := connector.Capabilities(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		object    string
+		operation common.Operation
+		expected  bool
+	}{
+		{name: "Profiles are readable", object: "profiles", operation: common.OperationRead, expected: true},
+		{name: "Profiles can be updated", object: "profiles", operation: common.OperationUpdate, expected: true},
+		{name: "Profiles cannot be deleted", object: "profiles", operation: common.OperationDelete, expected: false},
+		{name: "Tags can be deleted", object: "tags", operation: common.OperationDelete, expected: true},
+		{name: "Bulk jobs are write only", object: "event-bulk-create-jobs", operation: common.OperationRead, expected: false},
+		{name: "Bulk jobs can be created", object: "event-bulk-create-jobs", operation: common.OperationCreate, expected: true},
+		{name: "Unknown object supports nothing", object: "butterflies", operation: common.OperationRead, expected: false},
+	}
+
+	for _, tt := range tests {
+		if output := capabilities.Supports(tt.object, tt.operation); output != tt.expected {
+			t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, output)
+		}
+	}
+
+	// Incremental read depends on presence of timestamp field.
+	if !capabilities.Objects["profiles"].ReadCapabilities.Incremental {
+		t.Fatalf("profiles have updated field and must support incremental read")
+	}
+
+	if capabilities.Objects["tags"].ReadCapabilities.Incremental {
+		t.Fatalf("tags have no timestamp field and cannot be read incrementally")
+	}
+}
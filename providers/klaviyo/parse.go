@@ -1,10 +1,15 @@
 package klaviyo
 
 import (
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/jsonquery"
 	"github.com/spyzhov/ajson"
 )
 
+const keyAttributes = "attributes"
+
 // Every object has special field attributes which holds all the object specific fields.
 // Therefore, nested "attributes" will be removed and fields will be moved to the top level of the object.
 //
@@ -37,8 +42,6 @@ func flattenRecords(arr []*ajson.Node) ([]map[string]any, error) {
 	result := make([]map[string]any, len(arr))
 
 	for index, element := range arr {
-		const keyAttributes = "attributes"
-
 		attributes, err := jsonquery.New(element).Object(keyAttributes, true)
 		if err != nil {
 			return nil, err
@@ -68,6 +71,45 @@ func flattenRecords(arr []*ajson.Node) ([]map[string]any, error) {
 	return result, nil
 }
 
+// getMarshaledData resolves fields against flattened records.
+// Fields may still reference attributes by nested path, ex: "attributes.email" is the same as "email".
+func getMarshaledData(records []map[string]any, fields []string) ([]common.ReadResultRow, error) {
+	data, err := common.GetMarshaledData(records, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	for index, record := range records {
+		for _, field := range fields {
+			attribute, ok := attributePath(field)
+			if !ok {
+				continue
+			}
+
+			values := common.ExtractLowercaseFieldsFromRaw([]string{attribute}, record)
+			if value, found := values[strings.ToLower(attribute)]; found {
+				data[index].Fields[strings.ToLower(field)] = value
+			}
+		}
+	}
+
+	return data, nil
+}
+
+// attributePath returns the path relative to the attributes object.
+func attributePath(field string) (string, bool) {
+	if !jsonquery.IsNestedPath(field) {
+		return "", false
+	}
+
+	keys, err := jsonquery.ParsePath(field)
+	if err != nil || len(keys) < 2 || keys[0] != keyAttributes { // nolint:gomnd
+		return "", false
+	}
+
+	return strings.Join(keys[1:], "."), true
+}
+
 func getNextRecordsURL(node *ajson.Node) (string, error) {
 	return jsonquery.New(node, "links").StrWithDefault("next", "")
 }
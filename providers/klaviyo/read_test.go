@@ -3,6 +3,7 @@ package klaviyo
 import (
 	"errors"
 	"net/http"
+	"reflect"
 	"testing"
 	"time"
 
@@ -86,6 +87,32 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Profile attributes are selected by nested path",
+			Input: common.ReadParams{
+				ObjectName: "profiles",
+				Fields:     connectors.Fields("attributes.email", "$.attributes.location.city", "links.self"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentMIME("application/vnd.api+json"),
+				If:    mockcond.PathSuffix("/api/profiles"),
+				Then:  mockserver.Response(http.StatusOK, responseProfilesFirstPage),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return reflect.DeepEqual(actual.Data[0].Fields, expected.Data[0].Fields)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"attributes.email":           "jennifer@gmail.com",
+						"$.attributes.location.city": nil,
+						"links.self":                 "https://a.klaviyo.com/api/profiles/01HSXWNWF52J5PJG45BW383RMV/",
+					},
+				}},
+			},
+			ExpectedErrs: nil,
+		},
 		{
 			Name: "Incremental read of campaigns with required filter",
 			Input: common.ReadParams{
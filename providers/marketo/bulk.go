@@ -0,0 +1,657 @@
+package marketo
+
+import (
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+)
+
+// Bulk Extract API exports large data sets as CSV files in an asynchronous manner.
+// Job lifecycle: create -> enqueue -> poll status until completed -> download file.
+// https://experienceleague.adobe.com/en/docs/marketo-developer/marketo/rest/bulk-extract/bulk-extract
+const (
+	bulkAPIPrefix  = "bulk"
+	bulkAPIVersion = "v1"
+
+	// Date range filter of a single export job cannot exceed 31 days.
+	bulkExportMaxWindow = 31 * 24 * time.Hour
+
+	// Marketo recommends polling job status no more often than once per minute.
+	DefaultBulkExportPollInterval = time.Minute
+
+	// DefaultBulkExportWaitTimeout is how long a single Read waits for the export job.
+	DefaultBulkExportWaitTimeout = 5 * time.Minute
+
+	// DefaultBulkExportPageSize is the number of export file rows returned per page.
+	DefaultBulkExportPageSize = 10_000
+)
+
+// Job statuses returned by the status endpoint.
+const (
+	BulkExportStatusCreated    = "Created"
+	BulkExportStatusQueued     = "Queued"
+	BulkExportStatusProcessing = "Processing"
+	BulkExportStatusCancelled  = "Cancelled"
+	BulkExportStatusCompleted  = "Completed"
+	BulkExportStatusFailed     = "Failed"
+)
+
+var (
+	ErrBulkExportRequiresProgramID = errors.New("program members export requires Filter with program ids")
+	ErrInvalidProgramID            = errors.New("program id must be an integer")
+	ErrInvalidBulkExportPage       = errors.New("invalid bulk export page token")
+	ErrBulkExportFailed            = errors.New("bulk export job did not complete")
+	ErrBulkExportEmptyResponse     = errors.New("bulk export response has no job")
+)
+
+// BulkExportObject is an object which supports bulk extract.
+type BulkExportObject string
+
+const (
+	BulkExportLeads          BulkExportObject = "leads"
+	BulkExportActivities     BulkExportObject = "activities"
+	BulkExportProgramMembers BulkExportObject = "programMembers"
+)
+
+// bulkExportObjects describes how each object is exported.
+var bulkExportObjects = map[BulkExportObject]struct { // nolint:gochecknoglobals
+	// path is the URL segment of export endpoints.
+	path string
+	// dateFilter is the filter used to select records modified within date range.
+	dateFilter string
+	// dateFilterRequired is true if records cannot be exported without the date range filter.
+	dateFilterRequired bool
+}{
+	BulkExportLeads:          {path: "leads", dateFilter: "updatedAt", dateFilterRequired: true},
+	BulkExportActivities:     {path: "activities", dateFilter: "createdAt", dateFilterRequired: true},
+	BulkExportProgramMembers: {path: "program/members", dateFilter: "updatedAt"},
+}
+
+func isBulkExportObject(objectName string) bool {
+	_, ok := bulkExportObjects[BulkExportObject(objectName)]
+
+	return ok
+}
+
+// useBulkExport tells if the read should be done via Bulk Extract API.
+// Leads and activities without Since are listed using REST API, the same way as any other object,
+// because the export date range filter is mandatory for them.
+// Pages of the REST listing are continued using REST API as well.
+func useBulkExport(config common.ReadParams) bool {
+	if !bulkExportObjects[BulkExportObject(config.ObjectName)].dateFilterRequired {
+		return true
+	}
+
+	if len(config.NextPage) != 0 {
+		_, err := parseBulkPage(config.NextPage)
+
+		return err == nil
+	}
+
+	return !config.Since.IsZero()
+}
+
+// BulkExportRequest is the payload used to create an export job.
+type BulkExportRequest struct {
+	Fields []string `json:"fields,omitempty"`
+	Format string   `json:"format"`
+	// Filter is specific to exported object, ex: {"updatedAt": {"startAt": "...", "endAt": "..."}}.
+	Filter map[string]any `json:"filter"`
+}
+
+// BulkExportJob describes state of an export job.
+type BulkExportJob struct {
+	ExportID        string `json:"exportId"`
+	Format          string `json:"format"`
+	Status          string `json:"status"`
+	CreatedAt       string `json:"createdAt"`
+	QueuedAt        string `json:"queuedAt,omitempty"`
+	StartedAt       string `json:"startedAt,omitempty"`
+	FinishedAt      string `json:"finishedAt,omitempty"`
+	NumberOfRecords int64  `json:"numberOfRecords,omitempty"`
+	FileSize        int64  `json:"fileSize,omitempty"`
+	ErrorMsg        string `json:"errorMsg,omitempty"`
+}
+
+type bulkExportResponse struct {
+	Result  []BulkExportJob `json:"result"`
+	Success bool            `json:"success"`
+}
+
+// CreateBulkExport creates an export job. The job must be enqueued to start processing.
+func (c *Connector) CreateBulkExport(
+	ctx context.Context, object BulkExportObject, request BulkExportRequest,
+) (*BulkExportJob, error) {
+	bulkURL, err := c.getBulkURL(object, "create.json")
+	if err != nil {
+		return nil, err
+	}
+
+	if request.Format == "" {
+		request.Format = "CSV"
+	}
+
+	rsp, err := c.Client.Post(ctx, bulkURL.String(), request)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBulkExportJob(rsp)
+}
+
+// EnqueueBulkExport places created job in the processing queue.
+func (c *Connector) EnqueueBulkExport(
+	ctx context.Context, object BulkExportObject, exportID string,
+) (*BulkExportJob, error) {
+	bulkURL, err := c.getBulkURL(object, exportID, "enqueue.json")
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Post(ctx, bulkURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBulkExportJob(rsp)
+}
+
+// GetBulkExportStatus returns the current state of the job.
+func (c *Connector) GetBulkExportStatus(
+	ctx context.Context, object BulkExportObject, exportID string,
+) (*BulkExportJob, error) {
+	bulkURL, err := c.getBulkURL(object, exportID, "status.json")
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Get(ctx, bulkURL.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return parseBulkExportJob(rsp)
+}
+
+// WaitForBulkExport polls job status until the job is completed.
+// Cancelled and failed jobs are reported as ErrBulkExportFailed.
+func (c *Connector) WaitForBulkExport(
+	ctx context.Context, object BulkExportObject, exportID string,
+) (*BulkExportJob, error) {
+	ticker := time.NewTicker(c.bulkPollInterval)
+	defer ticker.Stop()
+
+	for {
+		job, err := c.GetBulkExportStatus(ctx, object, exportID)
+		if err != nil {
+			return nil, err
+		}
+
+		switch job.Status {
+		case BulkExportStatusCompleted:
+			return job, nil
+		case BulkExportStatusCancelled, BulkExportStatusFailed:
+			return nil, fmt.Errorf("%w: job %v is %v: %v", ErrBulkExportFailed, exportID, job.Status, job.ErrorMsg)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// BulkExportFilePage is a portion of the export file.
+type BulkExportFilePage struct {
+	Rows []common.ReadResultRow
+	// NextOffset is the byte offset of the first unread row.
+	// It is zero once the file is read to the end.
+	NextOffset int64
+}
+
+// ReadBulkExportFile downloads at most limit rows of CSV file of the completed job starting at the byte offset.
+// The file is streamed, every line is converted into a ReadResultRow.
+// Offset should be either zero or BulkExportFilePage.NextOffset of the previous call.
+func (c *Connector) ReadBulkExportFile(
+	ctx context.Context, object BulkExportObject, exportID string, fields []string, offset int64, limit int,
+) (*BulkExportFilePage, error) {
+	body, err := c.openBulkExportFile(ctx, object, exportID, offset)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(body)
+
+	csvReader := csv.NewReader(body)
+
+	var header []string
+	if offset == 0 {
+		header, err = readCSVHeader(csvReader)
+	} else {
+		// Header is at the start of the file, which was read by the previous pages.
+		header, err = c.readBulkExportHeader(ctx, object, exportID)
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	rows, nextOffset, err := parseCSVRows(csvReader, header, fields, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	if nextOffset != 0 {
+		nextOffset += offset
+	}
+
+	return &BulkExportFilePage{
+		Rows:       rows,
+		NextOffset: nextOffset,
+	}, nil
+}
+
+// readBulkExportHeader returns columns of the export file, which are listed on the first line.
+func (c *Connector) readBulkExportHeader(
+	ctx context.Context, object BulkExportObject, exportID string,
+) ([]string, error) {
+	body, err := c.openBulkExportFile(ctx, object, exportID, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	defer closeBody(body)
+
+	return readCSVHeader(csv.NewReader(body))
+}
+
+// openBulkExportFile starts downloading the file at the byte offset.
+// The caller must close the returned body.
+func (c *Connector) openBulkExportFile(
+	ctx context.Context, object BulkExportObject, exportID string, offset int64,
+) (io.ReadCloser, error) {
+	bulkURL, err := c.getBulkURL(object, exportID, "file.json")
+	if err != nil {
+		return nil, err
+	}
+
+	headers := []common.Header{{Key: "Accept", Value: "text/csv"}}
+	if offset > 0 {
+		// Partial retrieval of the file.
+		headers = append(headers, common.Header{Key: "Range", Value: fmt.Sprintf("bytes=%v-", offset)})
+	}
+
+	req, err := common.MakeGetRequest(ctx, bulkURL.String(), headers)
+	if err != nil {
+		return nil, err
+	}
+
+	// Response handler of the connector understands JSON bodies only, therefore it is not used for CSV files.
+	rsp, err := c.Client.HTTPClient.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if rsp.StatusCode < http.StatusOK || rsp.StatusCode >= http.StatusMultipleChoices {
+		defer closeBody(rsp.Body)
+
+		body, err := io.ReadAll(rsp.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return nil, common.InterpretError(rsp, body)
+	}
+
+	if offset > 0 && rsp.StatusCode != http.StatusPartialContent {
+		// Range header was ignored, the whole file is streamed, skip what was already read.
+		if _, err = io.CopyN(io.Discard, rsp.Body, offset); err != nil {
+			closeBody(rsp.Body)
+
+			return nil, err
+		}
+	}
+
+	return rsp.Body, nil
+}
+
+// bulkRead exports records of an object.
+// Every export job covers at most 31 days, starting at Since.
+// Program members can be exported without Since, then a single job selects all members of the programs.
+// Read waits for the job at most bulkWaitTimeout, a job which is still processing is resumed by the next Read.
+// Export file is read in pages of bulkPageSize rows.
+// NextPage either points to the job and the unread part of its file or holds the start of the following date range.
+func (c *Connector) bulkRead(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
+	object := BulkExportObject(config.ObjectName)
+	fields := config.Fields.List()
+	sort.Strings(fields)
+
+	page, err := c.getBulkPage(ctx, object, config, fields)
+	if err != nil {
+		return nil, err
+	}
+
+	if page.offset == 0 {
+		// File reading didn't start, the job may still be processing.
+		completed, err := c.awaitBulkExport(ctx, object, page.exportID)
+		if err != nil {
+			return nil, err
+		}
+
+		if !completed {
+			return &common.ReadResult{
+				Rows:     0,
+				Data:     []common.ReadResultRow{},
+				NextPage: page.token(),
+				Done:     false,
+			}, nil
+		}
+	}
+
+	filePage, err := c.ReadBulkExportFile(ctx, object, page.exportID, fields, page.offset, c.bulkPageSize)
+	if err != nil {
+		return nil, err
+	}
+
+	rows := filePage.Rows
+
+	if config.PreserveFieldCase {
+		records := make([]map[string]any, len(rows))
+		for index, row := range rows {
+			records[index] = row.Raw
+		}
+
+		if err = common.ApplyPreservedFieldCase(records, rows, common.GetMarshaledData, fields); err != nil {
+			return nil, err
+		}
+	}
+
+	result := &common.ReadResult{
+		Rows: int64(len(rows)),
+		Data: rows,
+		Done: true,
+	}
+
+	switch {
+	case filePage.NextOffset != 0:
+		page.offset = filePage.NextOffset
+		result.NextPage = page.token()
+		result.Done = false
+	case !page.nextStartAt.IsZero():
+		result.NextPage = common.NextPageToken(page.nextStartAt.Format(time.RFC3339))
+		result.Done = false
+	}
+
+	return result, nil
+}
+
+// getBulkPage returns the export job to read.
+// The job is created and enqueued when the read starts or moves to the next date range.
+func (c *Connector) getBulkPage(
+	ctx context.Context, object BulkExportObject, config common.ReadParams, fields []string,
+) (*bulkPage, error) {
+	page, err := parseBulkPage(config.NextPage)
+	if err != nil {
+		return nil, err
+	}
+
+	if page.exportID != "" {
+		// Continue reading the file of existing job.
+		return page, nil
+	}
+
+	filter, err := getBulkExportFilter(object, config)
+	if err != nil {
+		return nil, err
+	}
+
+	if page.startAt.IsZero() && !config.Since.IsZero() {
+		page.startAt = config.Since.UTC().Truncate(time.Second)
+	}
+
+	if !page.startAt.IsZero() {
+		now := time.Now().UTC()
+
+		endAt := page.startAt.Add(bulkExportMaxWindow)
+		if endAt.Before(now) {
+			page.nextStartAt = endAt
+		} else {
+			endAt = now
+		}
+
+		filter[bulkExportObjects[object].dateFilter] = map[string]string{
+			"startAt": page.startAt.Format(time.RFC3339),
+			"endAt":   endAt.Format(time.RFC3339),
+		}
+	}
+
+	page.exportID, err = c.startBulkExport(ctx, object, BulkExportRequest{
+		Fields: fields,
+		Filter: filter,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return page, nil
+}
+
+// getBulkExportFilter returns object specific filters.
+// Program members are always filtered by program ids, which are passed as comma separated ReadParams.Filter.
+func getBulkExportFilter(object BulkExportObject, config common.ReadParams) (map[string]any, error) {
+	filter := make(map[string]any)
+
+	if object != BulkExportProgramMembers {
+		return filter, nil
+	}
+
+	if strings.TrimSpace(config.Filter) == "" {
+		return nil, ErrBulkExportRequiresProgramID
+	}
+
+	identifiers := strings.Split(config.Filter, ",")
+	programIDs := make([]int, len(identifiers))
+
+	for index, identifier := range identifiers {
+		programID, err := strconv.Atoi(strings.TrimSpace(identifier))
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidProgramID, identifier)
+		}
+
+		programIDs[index] = programID
+	}
+
+	if len(programIDs) == 1 {
+		filter["programId"] = programIDs[0]
+	} else {
+		filter["programIds"] = programIDs
+	}
+
+	return filter, nil
+}
+
+// startBulkExport creates and enqueues the job. Returns export id.
+func (c *Connector) startBulkExport(
+	ctx context.Context, object BulkExportObject, request BulkExportRequest,
+) (string, error) {
+	job, err := c.CreateBulkExport(ctx, object, request)
+	if err != nil {
+		return "", err
+	}
+
+	if _, err = c.EnqueueBulkExport(ctx, object, job.ExportID); err != nil {
+		return "", err
+	}
+
+	return job.ExportID, nil
+}
+
+// awaitBulkExport waits for the job completion at most bulkWaitTimeout.
+// Returns false if the job is still processing once the time is up.
+func (c *Connector) awaitBulkExport(ctx context.Context, object BulkExportObject, exportID string) (bool, error) {
+	waitCtx, cancel := context.WithTimeout(ctx, c.bulkWaitTimeout)
+	defer cancel()
+
+	_, err := c.WaitForBulkExport(waitCtx, object, exportID)
+	if err != nil {
+		if waitCtx.Err() != nil && ctx.Err() == nil {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, nil
+}
+
+// bulkPage is the position of bulk read, it is stored in NextPage.
+// There are two formats of the token:
+//
+//	->	"2024-02-01T00:00:00Z" is the start of the next date range, new job must be created.
+//	->	"exportId=ID&offset=1024&next=2024-02-01T00:00:00Z" is the unread part of the export file.
+//		Zero offset means the job may still be processing.
+//		The start of the next date range is empty if this job is the last one.
+type bulkPage struct {
+	exportID    string
+	offset      int64
+	startAt     time.Time
+	nextStartAt time.Time
+}
+
+func parseBulkPage(token common.NextPageToken) (*bulkPage, error) {
+	page := &bulkPage{}
+
+	if len(token) == 0 {
+		return page, nil
+	}
+
+	if startAt, err := time.Parse(time.RFC3339, token.String()); err == nil {
+		page.startAt = startAt
+
+		return page, nil
+	}
+
+	values, err := url.ParseQuery(token.String())
+	if err != nil || values.Get("exportId") == "" {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBulkExportPage, token)
+	}
+
+	page.exportID = values.Get("exportId")
+
+	page.offset, err = strconv.ParseInt(values.Get("offset"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBulkExportPage, token)
+	}
+
+	if nextStartAt := values.Get("next"); nextStartAt != "" {
+		page.nextStartAt, err = time.Parse(time.RFC3339, nextStartAt)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", ErrInvalidBulkExportPage, token)
+		}
+	}
+
+	return page, nil
+}
+
+func (p bulkPage) token() common.NextPageToken {
+	values := url.Values{}
+	values.Set("exportId", p.exportID)
+	values.Set("offset", strconv.FormatInt(p.offset, 10))
+
+	if !p.nextStartAt.IsZero() {
+		values.Set("next", p.nextStartAt.Format(time.RFC3339))
+	}
+
+	return common.NextPageToken(values.Encode())
+}
+
+func (c *Connector) getBulkURL(object BulkExportObject, parts ...string) (*urlbuilder.URL, error) {
+	path := strings.Join(append([]string{
+		bulkAPIPrefix, bulkAPIVersion, bulkExportObjects[object].path, "export",
+	}, parts...), "/")
+
+	return urlbuilder.New(c.BaseURL, path)
+}
+
+func parseBulkExportJob(rsp *common.JSONHTTPResponse) (*BulkExportJob, error) {
+	response, err := common.UnmarshalJSON[bulkExportResponse](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	if response == nil || len(response.Result) == 0 {
+		return nil, ErrBulkExportEmptyResponse
+	}
+
+	return &response.Result[0], nil
+}
+
+// readCSVHeader reads the first line of CSV, which holds column names.
+func readCSVHeader(csvReader *csv.Reader) ([]string, error) {
+	header, err := csvReader.Read()
+	if errors.Is(err, io.EOF) {
+		return []string{}, nil
+	}
+
+	if err != nil {
+		return nil, errors.Join(common.ErrParseError, err)
+	}
+
+	return header, nil
+}
+
+// parseCSVRows reads at most limit lines of CSV using the header as column names.
+// Returns the offset of the first unread line relative to the start of the reader,
+// the offset is zero if there is nothing left to read.
+func parseCSVRows(
+	csvReader *csv.Reader, header []string, fields []string, limit int,
+) ([]common.ReadResultRow, int64, error) {
+	rows := make([]common.ReadResultRow, 0)
+
+	for {
+		offset := csvReader.InputOffset()
+
+		line, err := csvReader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, 0, nil
+		}
+
+		if err != nil {
+			return nil, 0, errors.Join(common.ErrParseError, err)
+		}
+
+		if len(rows) == limit {
+			// There are more lines, the last one is read again by the next page.
+			return rows, offset, nil
+		}
+
+		record := make(map[string]any, len(header))
+		for index, column := range header {
+			record[column] = line[index]
+		}
+
+		rows = append(rows, common.ReadResultRow{
+			Fields: common.ExtractLowercaseFieldsFromRaw(fields, record),
+			Raw:    record,
+		})
+	}
+}
+
+func closeBody(body io.Closer) {
+	if err := body.Close(); err != nil {
+		slog.Warn("unable to close response body", "error", err)
+	}
+}
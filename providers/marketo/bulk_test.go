@@ -0,0 +1,433 @@
+package marketo
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestBulkRead(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseCreated := testutils.DataFromFile(t, "bulk-export-created.json")
+	responseQueued := testutils.DataFromFile(t, "bulk-export-queued.json")
+	responseCompleted := testutils.DataFromFile(t, "bulk-export-completed.json")
+	responseFailed := testutils.DataFromFile(t, "bulk-export-failed.json")
+	responseFile := testutils.DataFromFile(t, "bulk-export-leads.csv")
+
+	recentSince := time.Now().UTC().Add(-48 * time.Hour).Truncate(time.Second)
+
+	// exportServer completes the job and returns the file.
+	// Status of the job is customizable to test failures.
+	exportServer := func(
+		objectPath string, createCondition mockcond.Condition, responseStatus []byte,
+	) *mockserver.Switch {
+		exportPath := "/bulk/v1/" + objectPath + "/export/ce45a7a1-f19d-4ce2-882c-a3c795940a7d"
+
+		return &mockserver.Switch{
+			Setup: mockserver.ContentJSON(),
+			Cases: []mockserver.Case{{
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/bulk/v1/" + objectPath + "/export/create.json"),
+					createCondition,
+				},
+				Then: mockserver.Response(http.StatusOK, responseCreated),
+			}, {
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix(exportPath + "/enqueue.json"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseQueued),
+			}, {
+				If:   mockcond.PathSuffix(exportPath + "/status.json"),
+				Then: mockserver.Response(http.StatusOK, responseStatus),
+			}, {
+				If:   mockcond.PathSuffix(exportPath + "/file.json"),
+				Then: mockserver.Response(http.StatusOK, responseFile),
+			}},
+		}
+	}
+
+	tests := []testroutines.Read{
+		{
+			Name:  "Leads without Since are listed via REST API",
+			Input: common.ReadParams{ObjectName: "leads", Fields: connectors.Fields("id")},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/rest/v1/leads.json"),
+				Then: mockserver.ResponseString(http.StatusOK,
+					`{"success":true,"result":[{"id":1031}],"nextPageToken":"GIYDAOBNGEYS2MBWKQYDAORQGA5DAMBOGAYDAKZQGAYDALBQ"}`),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Rows == expected.Rows
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": float64(1031)},
+				}},
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page of REST listing is read via REST API",
+			Input: common.ReadParams{
+				ObjectName: "activities",
+				Fields:     connectors.Fields("id"),
+				NextPage:   "GIYDAOBNGEYS2MBWKQYDAORQGA5DAMBOGAYDAKZQGAYDALBQ",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/rest/v1/activities.json"),
+					mockcond.QueryParam("nextPageToken", "GIYDAOBNGEYS2MBWKQYDAORQGA5DAMBOGAYDAKZQGAYDALBQ"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{"success":true,"result":[]}`),
+			}.Server(),
+			Expected:     &common.ReadResult{Rows: 0, Data: []common.ReadResultRow{}, Done: true},
+			ExpectedErrs: nil,
+		},
+		{
+			Name:         "Program members require program id",
+			Input:        common.ReadParams{ObjectName: "programMembers", Fields: connectors.Fields("leadId")},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrBulkExportRequiresProgramID},
+		},
+		{
+			Name: "Program members are exported by program ids without date range",
+			Input: common.ReadParams{
+				ObjectName: "programMembers",
+				Fields:     connectors.Fields("id"),
+				Filter:     "1044, 1045",
+			},
+			Server: exportServer("program/members",
+				mockcond.Body(`{"fields":["id"],"format":"CSV","filter":{"programIds":[1044,1045]}}`),
+				responseCompleted,
+			).Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return actual.Rows == expected.Rows &&
+					actual.NextPage == expected.NextPage &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows:     2,
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Recent changes are exported in a single job",
+			Input: common.ReadParams{
+				ObjectName: "leads",
+				Fields:     connectors.Fields("id", "email", "firstName"),
+				Since:      recentSince,
+			},
+			Server: exportServer("leads", mockcond.And{
+				mockcond.BodyContains(`"fields":["email","firstName","id"]`),
+				mockcond.BodyContains(`"format":"CSV"`),
+				mockcond.BodyContains(`"startAt":"` + recentSince.Format(time.RFC3339) + `"`),
+			}, responseCompleted).Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Rows == expected.Rows &&
+					actual.NextPage == expected.NextPage &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"id":        "1031",
+						"email":     "jsmith@example.com",
+						"firstname": "John",
+					},
+				}, {
+					Fields: map[string]any{
+						"id":        "1032",
+						"email":     "jdoe@example.com",
+						"firstname": "Jane, Jr.",
+					},
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Date range is split into windows of 31 days",
+			Input: common.ReadParams{
+				ObjectName: "leads",
+				Fields:     connectors.Fields("id"),
+				Since:      time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC),
+			},
+			Server: exportServer("leads",
+				mockcond.BodyContains(`"updatedAt":{"endAt":"2024-02-01T00:00:00Z","startAt":"2024-01-01T00:00:00Z"}`),
+				responseCompleted,
+			).Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return actual.Rows == expected.Rows &&
+					actual.NextPage == expected.NextPage &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows:     2,
+				NextPage: "2024-02-01T00:00:00Z",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page continues from the end of previous window",
+			Input: common.ReadParams{
+				ObjectName: "activities",
+				Fields:     connectors.Fields("marketoGUID"),
+				NextPage:   "2024-02-01T00:00:00Z",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/bulk/v1/activities/export/create.json"),
+					mockcond.BodyContains(`"createdAt":{"endAt":"2024-03-03T00:00:00Z","startAt":"2024-02-01T00:00:00Z"}`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{"success":true,"result":[]}`),
+			}.Server(),
+			ExpectedErrs: []error{ErrBulkExportEmptyResponse},
+		},
+		{
+			Name: "Failed job is reported",
+			Input: common.ReadParams{
+				ObjectName: "leads",
+				Fields:     connectors.Fields("id"),
+				Since:      recentSince,
+			},
+			Server:       exportServer("leads", mockcond.MethodPOST(), responseFailed).Server(),
+			ExpectedErrs: []error{ErrBulkExportFailed},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestBulkReadFilePages(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseCreated := testutils.DataFromFile(t, "bulk-export-created.json")
+	responseQueued := testutils.DataFromFile(t, "bulk-export-queued.json")
+	responseCompleted := testutils.DataFromFile(t, "bulk-export-completed.json")
+	responseFile := testutils.DataFromFile(t, "bulk-export-leads.csv")
+
+	exportPath := "/bulk/v1/leads/export/ce45a7a1-f19d-4ce2-882c-a3c795940a7d"
+	// Header and the first row take 79 bytes.
+	firstPageSize := 79
+
+	tests := []testroutines.Read{
+		{
+			Name: "First page of the file is limited by the page size",
+			Input: common.ReadParams{
+				ObjectName: "leads",
+				Fields:     connectors.Fields("id"),
+				Since:      time.Now().UTC().Add(-48 * time.Hour),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/bulk/v1/leads/export/create.json"),
+					Then: mockserver.Response(http.StatusOK, responseCreated),
+				}, {
+					If:   mockcond.PathSuffix(exportPath + "/enqueue.json"),
+					Then: mockserver.Response(http.StatusOK, responseQueued),
+				}, {
+					If:   mockcond.PathSuffix(exportPath + "/status.json"),
+					Then: mockserver.Response(http.StatusOK, responseCompleted),
+				}, {
+					If:   mockcond.PathSuffix(exportPath + "/file.json"),
+					Then: mockserver.Response(http.StatusOK, responseFile),
+				}},
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Rows == expected.Rows &&
+					actual.NextPage == expected.NextPage &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "1031"},
+				}},
+				NextPage: "exportId=ce45a7a1-f19d-4ce2-882c-a3c795940a7d&offset=79",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page resumes the file at the offset",
+			Input: common.ReadParams{
+				ObjectName: "leads",
+				Fields:     connectors.Fields("id", "firstName"),
+				NextPage:   "exportId=ce45a7a1-f19d-4ce2-882c-a3c795940a7d&next=2024-02-01T00%3A00%3A00Z&offset=79",
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If: mockcond.And{
+						mockcond.PathSuffix(exportPath + "/file.json"),
+						mockcond.Header(http.Header{"Range": []string{"bytes=79-"}}),
+					},
+					Then: mockserver.Response(http.StatusPartialContent, responseFile[firstPageSize:]),
+				}, {
+					If:   mockcond.PathSuffix(exportPath + "/file.json"),
+					Then: mockserver.Response(http.StatusOK, responseFile),
+				}},
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Rows == expected.Rows &&
+					actual.NextPage == expected.NextPage &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"id": "1032", "firstname": "Jane, Jr."},
+				}},
+				NextPage: "2024-02-01T00:00:00Z",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL, WithBulkExportPageSize(1))
+			})
+		})
+	}
+}
+
+func TestBulkReadProcessingJob(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseCreated := testutils.DataFromFile(t, "bulk-export-created.json")
+	responseQueued := testutils.DataFromFile(t, "bulk-export-queued.json")
+	responseCompleted := testutils.DataFromFile(t, "bulk-export-completed.json")
+	responseFile := testutils.DataFromFile(t, "bulk-export-leads.csv")
+
+	exportPath := "/bulk/v1/leads/export/ce45a7a1-f19d-4ce2-882c-a3c795940a7d"
+
+	tests := []testroutines.Read{
+		{
+			Name: "Job still processing is returned as next page",
+			Input: common.ReadParams{
+				ObjectName: "leads",
+				Fields:     connectors.Fields("id"),
+				Since:      time.Now().UTC().Add(-48 * time.Hour),
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix("/bulk/v1/leads/export/create.json"),
+					Then: mockserver.Response(http.StatusOK, responseCreated),
+				}, {
+					If:   mockcond.PathSuffix(exportPath + "/enqueue.json"),
+					Then: mockserver.Response(http.StatusOK, responseQueued),
+				}, {
+					If:   mockcond.PathSuffix(exportPath + "/status.json"),
+					Then: mockserver.Response(http.StatusOK, responseQueued),
+				}},
+			}.Server(),
+			Expected: &common.ReadResult{
+				Rows:     0,
+				Data:     []common.ReadResultRow{},
+				NextPage: "exportId=ce45a7a1-f19d-4ce2-882c-a3c795940a7d&offset=0",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page resumes waiting for the job without creating it again",
+			Input: common.ReadParams{
+				ObjectName: "leads",
+				Fields:     connectors.Fields("id"),
+				NextPage:   "exportId=ce45a7a1-f19d-4ce2-882c-a3c795940a7d&offset=0",
+			},
+			Server: mockserver.Switch{
+				Setup: mockserver.ContentJSON(),
+				Cases: []mockserver.Case{{
+					If:   mockcond.PathSuffix(exportPath + "/status.json"),
+					Then: mockserver.Response(http.StatusOK, responseCompleted),
+				}, {
+					If:   mockcond.PathSuffix(exportPath + "/file.json"),
+					Then: mockserver.Response(http.StatusOK, responseFile),
+				}},
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return actual.Rows == expected.Rows &&
+					actual.NextPage == expected.NextPage &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows:     2,
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL, WithBulkExportWaitTimeout(20*time.Millisecond))
+			})
+		})
+	}
+}
+
+func constructTestConnector(serverURL string, opts ...Option) (*Connector, error) {
+	connector, err := NewConnector(append([]Option{
+		WithAuthenticatedClient(http.DefaultClient),
+		WithWorkspace("test-workspace"),
+		WithModule(ModuleLeads),
+		WithBulkExportPollInterval(time.Millisecond),
+	}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// for testing we want to redirect calls to our mock server
+	connector.setBaseURL(serverURL)
+
+	return connector, nil
+}
@@ -2,6 +2,7 @@ package marketo
 
 import (
 	"strings"
+	"time"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/paramsbuilder"
@@ -13,6 +14,10 @@ type Connector struct {
 	BaseURL string
 	Client  *common.JSONHTTPClient
 	Module  common.Module
+
+	bulkPollInterval time.Duration
+	bulkWaitTimeout  time.Duration
+	bulkPageSize     int
 }
 
 func NewConnector(opts ...Option) (conn *Connector, outErr error) {
@@ -41,7 +46,22 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 				ResponseHandler: responseHandler,
 			},
 		},
-		Module: params.Module.Selection,
+		Module:           params.Module.Selection,
+		bulkPollInterval: params.bulkPollInterval,
+		bulkWaitTimeout:  params.bulkWaitTimeout,
+		bulkPageSize:     params.bulkPageSize,
+	}
+
+	if conn.bulkPollInterval <= 0 {
+		conn.bulkPollInterval = DefaultBulkExportPollInterval
+	}
+
+	if conn.bulkWaitTimeout <= 0 {
+		conn.bulkWaitTimeout = DefaultBulkExportWaitTimeout
+	}
+
+	if conn.bulkPageSize <= 0 {
+		conn.bulkPageSize = DefaultBulkExportPageSize
 	}
 
 	conn.setBaseURL(providerInfo.BaseURL)
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/paramsbuilder"
@@ -16,6 +17,10 @@ type parameters struct {
 	paramsbuilder.Client
 	paramsbuilder.Workspace
 	paramsbuilder.Module
+
+	bulkPollInterval time.Duration
+	bulkWaitTimeout  time.Duration
+	bulkPageSize     int
 }
 
 func (p parameters) ValidateParams() error {
@@ -55,3 +60,28 @@ func WithAuthenticatedClient(client common.AuthenticatedHTTPClient) Option {
 		params.WithAuthenticatedClient(client)
 	}
 }
+
+// WithBulkExportPollInterval sets how often the status of bulk export job is checked.
+// Defaults to DefaultBulkExportPollInterval.
+func WithBulkExportPollInterval(interval time.Duration) Option {
+	return func(params *parameters) {
+		params.bulkPollInterval = interval
+	}
+}
+
+// WithBulkExportWaitTimeout sets how long a single Read waits for the export job to complete.
+// If the job is still processing, Read returns no rows and NextPage pointing to the job,
+// the next Read resumes waiting. Defaults to DefaultBulkExportWaitTimeout.
+func WithBulkExportWaitTimeout(timeout time.Duration) Option {
+	return func(params *parameters) {
+		params.bulkWaitTimeout = timeout
+	}
+}
+
+// WithBulkExportPageSize sets how many rows of the export file are returned per page.
+// Defaults to DefaultBulkExportPageSize.
+func WithBulkExportPageSize(size int) Option {
+	return func(params *parameters) {
+		params.bulkPageSize = size
+	}
+}
@@ -7,11 +7,17 @@ import (
 )
 
 // Read retrieves data based on the provided common.ReadParams configuration parameters.
+// Incremental reads of leads and activities of the Leads module are done via Bulk Extract API,
+// without Since they are listed via REST API. Program members are always read via Bulk Extract API.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	if err := config.ValidateParams(true); err != nil {
 		return nil, err
 	}
 
+	if c.Module.ID == ModuleLeads && isBulkExportObject(config.ObjectName) && useBulkExport(config) {
+		return c.bulkRead(ctx, config)
+	}
+
 	url, err := c.getURL(config)
 	if err != nil {
 		return nil, err
@@ -27,5 +33,6 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		getNextRecordsURL,
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
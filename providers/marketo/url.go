@@ -28,7 +28,7 @@ func (c *Connector) getURL(params common.ReadParams) (*urlbuilder.URL, error) {
 			url.WithQueryParam("earliestUpdatedAt", fmtTime)
 			url.WithQueryParam("latestUpdatedAt", time.Now().Format(time.RFC3339))
 
-		default: // we currently don't support filtering in leads.
+		default: // leads and activities are filtered using bulk export, see bulkRead.
 		}
 	}
 
@@ -0,0 +1,224 @@
+package providers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/amp-labs/connectors/internal/goutils"
+	"github.com/invopop/yaml"
+)
+
+var (
+	ErrInvalidOverlay           = errors.New("invalid catalog overlay")
+	ErrUnsupportedOverlayFormat = errors.New("overlay file must be JSON or YAML")
+	ErrOverlayAuthMismatch      = errors.New("overlay doesn't match provider auth type")
+)
+
+// CatalogOverlay patches individual provider entries, leaving the rest of the catalog intact.
+// Example: point HubSpot at the EU datacenter or Salesforce at a sandbox login domain.
+type CatalogOverlay struct {
+	// Name identifies the overlay in error messages, ex: "salesforce-sandbox".
+	Name      string                           `json:"name,omitempty"`
+	Providers map[Provider]ProviderInfoOverlay `json:"providers"`
+}
+
+// ProviderInfoOverlay holds fields of ProviderInfo which can be overridden.
+// Nil fields are left unchanged.
+type ProviderInfoOverlay struct {
+	DisplayName *string            `json:"displayName,omitempty"`
+	BaseURL     *string            `json:"baseURL,omitempty"`
+	Oauth2Opts  *Oauth2OptsOverlay `json:"oauth2Opts,omitempty"`
+	// ProviderOpts are merged with existing options. Empty value removes the key.
+	ProviderOpts map[string]string `json:"providerOpts,omitempty"`
+	// Labels are merged with existing labels. Empty value removes the key.
+	Labels  map[string]string `json:"labels,omitempty"`
+	Support *SupportOverlay   `json:"support,omitempty"`
+}
+
+// Oauth2OptsOverlay holds OAuth endpoints which can be overridden.
+// Applicable only to providers with oauth2 auth type.
+type Oauth2OptsOverlay struct {
+	AuthURL  *string `json:"authURL,omitempty"`
+	TokenURL *string `json:"tokenURL,omitempty"`
+	// AuthURLParams are merged with existing parameters. Empty value removes the key.
+	AuthURLParams map[string]string `json:"authURLParams,omitempty"`
+	// Audience replaces the list when not nil.
+	Audience []string `json:"audience,omitempty"`
+}
+
+// SupportOverlay toggles supported features.
+type SupportOverlay struct {
+	Read      *bool             `json:"read,omitempty"`
+	Write     *bool             `json:"write,omitempty"`
+	Proxy     *bool             `json:"proxy,omitempty"`
+	Subscribe *bool             `json:"subscribe,omitempty"`
+	BulkWrite *BulkWriteSupport `json:"bulkWrite,omitempty"`
+}
+
+// WithOverlays is an option that patches provider entries of the catalog.
+// Overlays are applied in order, therefore later overlays take precedence.
+// The catalog supplied via WithCatalog is patched as well, regardless of option order.
+func WithOverlays(overlays ...CatalogOverlay) CatalogOption {
+	return func(params *catalogParams) {
+		params.overlays = append(params.overlays, overlays...)
+	}
+}
+
+// LoadCatalogOverlay reads overlay from a file. Format is decided by the extension: .json, .yaml or .yml.
+func LoadCatalogOverlay(path string) (*CatalogOverlay, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	switch strings.ToLower(filepath.Ext(path)) {
+	case ".json":
+		return ParseCatalogOverlayJSON(data)
+	case ".yaml", ".yml":
+		return ParseCatalogOverlayYAML(data)
+	default:
+		return nil, fmt.Errorf("%w: %v", ErrUnsupportedOverlayFormat, path)
+	}
+}
+
+// ParseCatalogOverlayJSON decodes overlay from JSON.
+func ParseCatalogOverlayJSON(data []byte) (*CatalogOverlay, error) {
+	var overlay CatalogOverlay
+	if err := json.Unmarshal(data, &overlay); err != nil {
+		return nil, errors.Join(ErrInvalidOverlay, err)
+	}
+
+	return &overlay, nil
+}
+
+// ParseCatalogOverlayYAML decodes overlay from YAML. Keys are the same as in JSON.
+func ParseCatalogOverlayYAML(data []byte) (*CatalogOverlay, error) {
+	var overlay CatalogOverlay
+	if err := yaml.Unmarshal(data, &overlay); err != nil {
+		return nil, errors.Join(ErrInvalidOverlay, err)
+	}
+
+	return &overlay, nil
+}
+
+// applyOverlays returns a patched copy of the catalog. The original catalog is not modified.
+func applyOverlays(catalogInstance *CatalogWrapper, overlays []CatalogOverlay) (*CatalogWrapper, error) {
+	result, err := goutils.Clone[*CatalogWrapper](catalogInstance)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, overlay := range overlays {
+		if err := overlay.applyTo(result.Catalog); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+func (o CatalogOverlay) applyTo(catalogType CatalogType) error {
+	providerNames := make([]Provider, 0, len(o.Providers))
+	for provider := range o.Providers {
+		providerNames = append(providerNames, provider)
+	}
+
+	// Deterministic order for error reporting.
+	sort.Strings(providerNames)
+
+	for _, provider := range providerNames {
+		info, ok := catalogType[provider]
+		if !ok {
+			return fmt.Errorf("%w: overlay %q: %w: %v", ErrInvalidOverlay, o.Name, ErrProviderNotFound, provider)
+		}
+
+		if err := o.Providers[provider].applyTo(&info); err != nil {
+			return fmt.Errorf("%w: overlay %q: %v: %w", ErrInvalidOverlay, o.Name, provider, err)
+		}
+
+		catalogType[provider] = info
+	}
+
+	return nil
+}
+
+func (o ProviderInfoOverlay) applyTo(info *ProviderInfo) error {
+	setIfPresent(&info.DisplayName, o.DisplayName)
+	setIfPresent(&info.BaseURL, o.BaseURL)
+
+	if o.Oauth2Opts != nil {
+		if info.Oauth2Opts == nil {
+			return fmt.Errorf("%w: oauth2Opts cannot be patched for %v auth type", ErrOverlayAuthMismatch, info.AuthType)
+		}
+
+		o.Oauth2Opts.applyTo(info.Oauth2Opts)
+	}
+
+	if o.ProviderOpts != nil {
+		if info.ProviderOpts == nil {
+			info.ProviderOpts = make(ProviderOpts)
+		}
+
+		mergeStringMap(info.ProviderOpts, o.ProviderOpts)
+	}
+
+	if o.Labels != nil {
+		if info.Labels == nil {
+			info.Labels = &Labels{}
+		}
+
+		mergeStringMap(*info.Labels, o.Labels)
+	}
+
+	if o.Support != nil {
+		o.Support.applyTo(&info.Support)
+	}
+
+	return nil
+}
+
+func (o Oauth2OptsOverlay) applyTo(opts *Oauth2Opts) {
+	setIfPresent(&opts.AuthURL, o.AuthURL)
+	setIfPresent(&opts.TokenURL, o.TokenURL)
+
+	if o.AuthURLParams != nil {
+		if opts.AuthURLParams == nil {
+			opts.AuthURLParams = make(map[string]string)
+		}
+
+		mergeStringMap(opts.AuthURLParams, o.AuthURLParams)
+	}
+
+	if o.Audience != nil {
+		opts.Audience = o.Audience
+	}
+}
+
+func (o SupportOverlay) applyTo(support *Support) {
+	setIfPresent(&support.Read, o.Read)
+	setIfPresent(&support.Write, o.Write)
+	setIfPresent(&support.Proxy, o.Proxy)
+	setIfPresent(&support.Subscribe, o.Subscribe)
+	setIfPresent(&support.BulkWrite, o.BulkWrite)
+}
+
+func setIfPresent[T any](target *T, value *T) {
+	if value != nil {
+		*target = *value
+	}
+}
+
+func mergeStringMap(target, patch map[string]string) {
+	for key, value := range patch {
+		if value == "" {
+			delete(target, key)
+		} else {
+			target[key] = value
+		}
+	}
+}
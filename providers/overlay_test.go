@@ -0,0 +1,186 @@
+package providers
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/internal/goutils"
+)
+
+func TestCatalogOverlay(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	base := &CatalogWrapper{
+		Timestamp: time.Now().Format(time.RFC3339),
+		Catalog: CatalogType{
+			"test": {
+				Name:     "test",
+				AuthType: Oauth2,
+				BaseURL:  "https://api.test.com",
+				Oauth2Opts: &Oauth2Opts{
+					AuthURL:  "https://test.com/oauth/authorize",
+					TokenURL: "https://test.com/oauth/token",
+				},
+				ProviderOpts: ProviderOpts{"region": "us", "legacy": "true"},
+				Support:      Support{Read: true, Write: true},
+			},
+			"keyed": {
+				Name:     "keyed",
+				AuthType: ApiKey,
+				BaseURL:  "https://api.keyed.com",
+			},
+		},
+	}
+
+	tests := []struct {
+		name         string
+		input        []CatalogOverlay
+		expected     ProviderInfo
+		expectedErrs []error
+	}{
+		{
+			name: "Individual fields are patched",
+			input: []CatalogOverlay{{
+				Name: "eu",
+				Providers: map[Provider]ProviderInfoOverlay{
+					"test": {
+						BaseURL: goutils.Pointer("https://api.eu.test.com"),
+						Oauth2Opts: &Oauth2OptsOverlay{
+							TokenURL: goutils.Pointer("https://eu.test.com/oauth/token"),
+						},
+						ProviderOpts: map[string]string{"region": "eu", "legacy": ""},
+						Support:      &SupportOverlay{Write: goutils.Pointer(false)},
+					},
+				},
+			}},
+			expected: ProviderInfo{
+				Name:     "test",
+				AuthType: Oauth2,
+				BaseURL:  "https://api.eu.test.com",
+				Oauth2Opts: &Oauth2Opts{
+					AuthURL:  "https://test.com/oauth/authorize",
+					TokenURL: "https://eu.test.com/oauth/token",
+				},
+				ProviderOpts: ProviderOpts{"region": "eu"},
+				Support:      Support{Read: true, Write: false},
+			},
+		},
+		{
+			name: "Later overlays take precedence",
+			input: []CatalogOverlay{{
+				Providers: map[Provider]ProviderInfoOverlay{
+					"test": {BaseURL: goutils.Pointer("https://first.test.com")},
+				},
+			}, {
+				Providers: map[Provider]ProviderInfoOverlay{
+					"test": {BaseURL: goutils.Pointer("https://second.test.com")},
+				},
+			}},
+			expected: ProviderInfo{
+				Name:     "test",
+				AuthType: Oauth2,
+				BaseURL:  "https://second.test.com",
+				Oauth2Opts: &Oauth2Opts{
+					AuthURL:  "https://test.com/oauth/authorize",
+					TokenURL: "https://test.com/oauth/token",
+				},
+				ProviderOpts: ProviderOpts{"region": "us", "legacy": "true"},
+				Support:      Support{Read: true, Write: true},
+			},
+		},
+		{
+			name: "Unknown provider cannot be patched",
+			input: []CatalogOverlay{{
+				Providers: map[Provider]ProviderInfoOverlay{
+					"unknown": {BaseURL: goutils.Pointer("https://unknown.com")},
+				},
+			}},
+			expectedErrs: []error{ErrInvalidOverlay, ErrProviderNotFound},
+		},
+		{
+			name: "OAuth endpoints cannot be patched for API key provider",
+			input: []CatalogOverlay{{
+				Providers: map[Provider]ProviderInfoOverlay{
+					"keyed": {Oauth2Opts: &Oauth2OptsOverlay{TokenURL: goutils.Pointer("https://keyed.com")}},
+				},
+			}},
+			expectedErrs: []error{ErrInvalidOverlay, ErrOverlayAuthMismatch},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := NewCustomCatalog(WithCatalog(base), WithOverlays(tt.input...)).ReadInfo("test")
+
+			for _, expectedErr := range tt.expectedErrs {
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("%s: expected Error: (%v), got: (%v)", tt.name, expectedErr, err)
+				}
+			}
+
+			if len(tt.expectedErrs) != 0 {
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s: expected no errors, got: (%v)", tt.name, err)
+			}
+
+			if !reflect.DeepEqual(*output, tt.expected) {
+				t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, *output)
+			}
+
+			if base.Catalog["test"].BaseURL != "https://api.test.com" {
+				t.Fatalf("%s: base catalog was modified", tt.name)
+			}
+		})
+	}
+}
+
+func TestParseCatalogOverlay(t *testing.T) {
+	t.Parallel()
+
+	expected := &CatalogOverlay{
+		Name: "hubspot-eu",
+		Providers: map[Provider]ProviderInfoOverlay{
+			Hubspot: {
+				BaseURL: goutils.Pointer("https://api-eu1.hubapi.com"),
+				Support: &SupportOverlay{Read: goutils.Pointer(true)},
+			},
+		},
+	}
+
+	fromYAML, err := ParseCatalogOverlayYAML([]byte(`
+name: hubspot-eu
+providers:
+  hubspot:
+    baseURL: https://api-eu1.hubapi.com
+    support:
+      read: true
+`))
+	if err != nil {
+		t.Fatalf("failed to parse YAML: %v", err)
+	}
+
+	fromJSON, err := ParseCatalogOverlayJSON([]byte(`{
+		"name": "hubspot-eu",
+		"providers": {"hubspot": {"baseURL": "https://api-eu1.hubapi.com", "support": {"read": true}}}
+	}`))
+	if err != nil {
+		t.Fatalf("failed to parse JSON: %v", err)
+	}
+
+	if !reflect.DeepEqual(fromYAML, expected) {
+		t.Fatalf("YAML: expected: (%v), got: (%v)", expected, fromYAML)
+	}
+
+	if !reflect.DeepEqual(fromJSON, expected) {
+		t.Fatalf("JSON: expected: (%v), got: (%v)", expected, fromJSON)
+	}
+}
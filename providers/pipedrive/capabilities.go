@@ -0,0 +1,21 @@
+package pipedrive
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/pipedrive/metadata"
+)
+
+// Capabilities lists objects of the static schema. Create and update are reported only where the
+// Pipedrive OpenAPI file has POST or PATCH/PUT endpoints, ex: deals or persons, while objects like currencies
+// or recents are read only. Deletes aren't reported, the connector doesn't implement Delete.
+// Since is passed as start_date, which is honoured by activities and notes only.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return metadata.Schemas.Capabilities(c.Module.ID, func(objectName string) common.ReadCapabilities {
+		return common.ReadCapabilities{
+			Incremental: objectName == "activities" || objectName == "notes",
+			Pagination:  common.PaginationOffset,
+		}
+	}, common.OperationCreate, common.OperationUpdate), nil
+}
@@ -0,0 +1,54 @@
+package pipedrive
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Dummy()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	capabilities, err := connector.Capabilities(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	tests := []struct {
+		name      string
+		object    string
+		operation common.Operation
+		expected  bool
+	}{
+		{name: "Deals are readable", object: "deals", operation: common.OperationRead, expected: true},
+		{name: "Deals can be created", object: "deals", operation: common.OperationCreate, expected: true},
+		{name: "Connector doesn't implement delete", object: "deals", operation: common.OperationDelete, expected: false},
+		{name: "Call logs cannot be updated", object: "callLogs", operation: common.OperationUpdate, expected: false},
+		{name: "Currencies are read only", object: "currencies", operation: common.OperationCreate, expected: false},
+		{name: "Unknown object supports nothing", object: "butterflies", operation: common.OperationRead, expected: false},
+	}
+
+	for _, tt := range tests {
+		if output := capabilities.Supports(tt.object, tt.operation); output != tt.expected {
+			t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, output)
+		}
+	}
+
+	if !capabilities.Objects["notes"].ReadCapabilities.Incremental {
+		t.Fatalf("notes accept start_date and must support incremental read")
+	}
+
+	if capabilities.Objects["deals"].ReadCapabilities.Incremental {
+		t.Fatalf("deals ignore start_date and cannot be read incrementally")
+	}
+}
@@ -85,5 +85,10 @@ func metadataMapper(
 		mdt.FieldsMap[fld] = fld
 	}
 
+	// Field types are known only from the static schema file, custom fields remain untyped.
+	if schema, err := metadata.Schemas.SelectOne(moduleID, obj); err == nil {
+		mdt.FieldTypes = schema.FieldTypes
+	}
+
 	return mdt, nil
 }
@@ -44,6 +44,15 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 							"name":           "name",
 							"symbol":         "symbol",
 						},
+						FieldTypes: common.FieldTypes{
+							"active_flag":    common.ValueTypeBoolean,
+							"code":           common.ValueTypeString,
+							"decimal_points": common.ValueTypeInt,
+							"id":             common.ValueTypeInt,
+							"is_custom_flag": common.ValueTypeBoolean,
+							"name":           common.ValueTypeString,
+							"symbol":         common.ValueTypeString,
+						},
 					},
 				},
 				Errors: map[string]error{},
@@ -124,6 +133,69 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 							"update_user_id":                "update_user_id",
 							"user_id":                       "user_id",
 						},
+						FieldTypes: common.FieldTypes{
+							"active_flag":                   common.ValueTypeBoolean,
+							"add_time":                      common.ValueTypeDateTime,
+							"assigned_to_user_id":           common.ValueTypeInt,
+							"attendees":                     common.ValueTypeOther,
+							"busy_flag":                     common.ValueTypeBoolean,
+							"calendar_sync_include_context": common.ValueTypeString,
+							"company_id":                    common.ValueTypeInt,
+							"conference_meeting_client":     common.ValueTypeString,
+							"conference_meeting_id":         common.ValueTypeString,
+							"conference_meeting_url":        common.ValueTypeString,
+							"created_by_user_id":            common.ValueTypeInt,
+							"deal_dropbox_bcc":              common.ValueTypeString,
+							"deal_id":                       common.ValueTypeInt,
+							"deal_title":                    common.ValueTypeString,
+							"done":                          common.ValueTypeBoolean,
+							"due_date":                      common.ValueTypeDate,
+							"due_time":                      common.ValueTypeString,
+							"duration":                      common.ValueTypeString,
+							"file":                          common.ValueTypeOther,
+							"gcal_event_id":                 common.ValueTypeString,
+							"google_calendar_etag":          common.ValueTypeString,
+							"google_calendar_id":            common.ValueTypeString,
+							"id":                            common.ValueTypeInt,
+							"last_notification_time":        common.ValueTypeDateTime,
+							"last_notification_user_id":     common.ValueTypeInt,
+							"lead_id":                       common.ValueTypeString,
+							"location":                      common.ValueTypeString,
+							"location_admin_area_level_1":   common.ValueTypeString,
+							"location_admin_area_level_2":   common.ValueTypeString,
+							"location_country":              common.ValueTypeString,
+							"location_formatted_address":    common.ValueTypeString,
+							"location_locality":             common.ValueTypeString,
+							"location_postal_code":          common.ValueTypeString,
+							"location_route":                common.ValueTypeString,
+							"location_street_number":        common.ValueTypeString,
+							"location_sublocality":          common.ValueTypeString,
+							"location_subpremise":           common.ValueTypeString,
+							"marked_as_done_time":           common.ValueTypeDateTime,
+							"note":                          common.ValueTypeString,
+							"notification_language_id":      common.ValueTypeInt,
+							"org_id":                        common.ValueTypeInt,
+							"org_name":                      common.ValueTypeString,
+							"owner_name":                    common.ValueTypeString,
+							"participants":                  common.ValueTypeOther,
+							"person_dropbox_bcc":            common.ValueTypeString,
+							"person_id":                     common.ValueTypeInt,
+							"person_name":                   common.ValueTypeString,
+							"project_id":                    common.ValueTypeInt,
+							"public_description":            common.ValueTypeString,
+							"rec_master_activity_id":        common.ValueTypeInt,
+							"rec_rule":                      common.ValueTypeString,
+							"rec_rule_extension":            common.ValueTypeString,
+							"reference_id":                  common.ValueTypeInt,
+							"reference_type":                common.ValueTypeString,
+							"series":                        common.ValueTypeOther,
+							"source_timezone":               common.ValueTypeString,
+							"subject":                       common.ValueTypeString,
+							"type":                          common.ValueTypeString,
+							"update_time":                   common.ValueTypeDateTime,
+							"update_user_id":                common.ValueTypeInt,
+							"user_id":                       common.ValueTypeInt,
+						},
 					},
 				},
 				Errors: map[string]error{},
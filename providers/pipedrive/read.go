@@ -30,6 +30,7 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 		nextRecordsURL(url),
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
 
@@ -0,0 +1,18 @@
+package pipeliner
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/pipeliner/metadata"
+)
+
+// Capabilities is derived from the static schema. Pipeliner has no time filtering, every read is a full read.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return metadata.Schemas.Capabilities(c.Module.ID, func(string) common.ReadCapabilities {
+		return common.ReadCapabilities{
+			Pagination:  common.PaginationCursor,
+			MaxPageSize: DefaultPageSize,
+		}
+	}, common.OperationCreate, common.OperationUpdate, common.OperationDelete), nil
+}
@@ -0,0 +1,141 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
+)
+
+var ErrUnknownRegion = errors.New("region is not supported by provider")
+
+// RegionOpts declares values of the `{{.region}}` catalog variable accepted by the provider.
+// Region may denote a datacenter, ex: "eu", or an environment, ex: "sandbox".
+type RegionOpts struct {
+	// Default region is used when none is specified.
+	Default string
+	// Hosts maps region name to the text substituted into URLs.
+	Hosts map[string]string
+}
+
+// Names returns sorted list of supported regions.
+func (o RegionOpts) Names() []string {
+	names := make([]string, 0, len(o.Hosts))
+	for name := range o.Hosts {
+		names = append(names, name)
+	}
+
+	sort.Strings(names)
+
+	return names
+}
+
+// regions of providers which have region specific URLs.
+var regions = make(map[Provider]RegionOpts) // nolint:gochecknoglobals
+
+// SetRegions declares regions supported by the provider.
+// Like SetInfo, it is not thread-safe and is meant to be called from init().
+func SetRegions(provider Provider, opts RegionOpts) {
+	regions[provider] = opts
+}
+
+// ReadRegions returns regions supported by the provider.
+// Second value is false if provider is available in a single region.
+func ReadRegions(provider Provider) (*RegionOpts, bool) {
+	opts, ok := regions[provider]
+	if !ok {
+		return nil, false
+	}
+
+	return &opts, true
+}
+
+// resolveRegion translates region name into the value expected by catalog templates.
+// Default region is added if provider has regions, yet region is not specified.
+// When no variables are given, others are kept as templates, so that only the region is substituted.
+func resolveRegion(
+	provider Provider, vars []catalogreplacer.CatalogVariable,
+) ([]catalogreplacer.CatalogVariable, error) {
+	opts, ok := ReadRegions(provider)
+	if !ok {
+		return vars, nil
+	}
+
+	result := make([]catalogreplacer.CatalogVariable, 0, len(vars)+1)
+	name := opts.Default
+
+	for _, variable := range vars {
+		plan := variable.GetSubstitutionPlan()
+		if plan.From != catalogreplacer.VariableRegion {
+			result = append(result, variable)
+
+			continue
+		}
+
+		if plan.To != "" {
+			name = strings.ToLower(plan.To)
+		}
+	}
+
+	host, ok := opts.Hosts[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %v doesn't support %q, choose one of %v",
+			ErrUnknownRegion, provider, name, strings.Join(opts.Names(), ", "))
+	}
+
+	if len(vars) == 0 {
+		result = unresolvedVariables()
+	}
+
+	return append(result, catalogreplacer.CustomCatalogVariable{
+		Plan: catalogreplacer.SubstitutionPlan{
+			From: catalogreplacer.VariableRegion,
+			To:   host,
+		},
+	}), nil
+}
+
+// unresolvedVariables substitutes every variable, except region, with its own template.
+func unresolvedVariables() []catalogreplacer.CatalogVariable {
+	names := catalogreplacer.Variables()
+	result := make([]catalogreplacer.CatalogVariable, 0, len(names))
+
+	for _, name := range names {
+		if name == catalogreplacer.VariableRegion {
+			continue
+		}
+
+		result = append(result, catalogreplacer.CustomCatalogVariable{
+			Plan: catalogreplacer.SubstitutionPlan{
+				From: name,
+				To:   "{{." + name + "}}",
+			},
+		})
+	}
+
+	return result
+}
+
+// applyDefaultRegions substitutes the default region of every provider in the catalog.
+func applyDefaultRegions(catalog CatalogType) error {
+	for provider, info := range catalog {
+		if _, ok := ReadRegions(provider); !ok {
+			continue
+		}
+
+		vars, err := resolveRegion(provider, nil)
+		if err != nil {
+			return err
+		}
+
+		if err = info.SubstituteWith(vars); err != nil {
+			return err
+		}
+
+		catalog[provider] = info
+	}
+
+	return nil
+}
@@ -0,0 +1,138 @@
+package providers
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
+)
+
+func TestReadInfoWithRegion(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	region := func(name string) catalogreplacer.CatalogVariable {
+		return catalogreplacer.CustomCatalogVariable{Plan: catalogreplacer.SubstitutionPlan{
+			From: catalogreplacer.VariableRegion,
+			To:   name,
+		}}
+	}
+
+	workspace := catalogreplacer.CustomCatalogVariable{Plan: catalogreplacer.SubstitutionPlan{
+		From: catalogreplacer.VariableWorkspace,
+		To:   "acme",
+	}}
+
+	tests := []struct {
+		name             string
+		provider         Provider
+		vars             []catalogreplacer.CatalogVariable
+		expectedBaseURL  string
+		expectedTokenURL string
+		expectedErrs     []error
+	}{
+		{
+			name:             "Zoho EU datacenter",
+			provider:         Zoho,
+			vars:             []catalogreplacer.CatalogVariable{region("eu")},
+			expectedBaseURL:  "https://www.zohoapis.eu",
+			expectedTokenURL: "https://accounts.zoho.eu/oauth/v2/token",
+		},
+		{
+			name:             "Zoho empty region falls back to default",
+			provider:         Zoho,
+			vars:             []catalogreplacer.CatalogVariable{region("")},
+			expectedBaseURL:  "https://www.zohoapis.com",
+			expectedTokenURL: "https://accounts.zoho.com/oauth/v2/token",
+		},
+		{
+			name:         "Zoho unknown region",
+			provider:     Zoho,
+			vars:         []catalogreplacer.CatalogVariable{region("mars")},
+			expectedErrs: []error{ErrUnknownRegion},
+		},
+		{
+			name:             "Salesforce production is the default",
+			provider:         Salesforce,
+			vars:             []catalogreplacer.CatalogVariable{workspace},
+			expectedBaseURL:  "https://acme.my.salesforce.com",
+			expectedTokenURL: "https://acme.my.salesforce.com/services/oauth2/token",
+		},
+		{
+			name:             "Salesforce sandbox",
+			provider:         Salesforce,
+			vars:             []catalogreplacer.CatalogVariable{workspace, region("sandbox")},
+			expectedBaseURL:  "https://acme.sandbox.my.salesforce.com",
+			expectedTokenURL: "https://acme.sandbox.my.salesforce.com/services/oauth2/token",
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			info, err := ReadInfo(tt.provider, tt.vars...)
+
+			for _, expectedErr := range tt.expectedErrs {
+				if !errors.Is(err, expectedErr) {
+					t.Fatalf("%s: expected Error: (%v), got: (%v)", tt.name, expectedErr, err)
+				}
+			}
+
+			if len(tt.expectedErrs) != 0 {
+				return
+			}
+
+			if err != nil {
+				t.Fatalf("%s: expected no errors, got: (%v)", tt.name, err)
+			}
+
+			if info.BaseURL != tt.expectedBaseURL {
+				t.Fatalf("%s: expected base URL: (%v), got: (%v)", tt.name, tt.expectedBaseURL, info.BaseURL)
+			}
+
+			if info.Oauth2Opts.TokenURL != tt.expectedTokenURL {
+				t.Fatalf("%s: expected token URL: (%v), got: (%v)", tt.name, tt.expectedTokenURL, info.Oauth2Opts.TokenURL)
+			}
+		})
+	}
+}
+
+func TestDefaultRegionWithoutVariables(t *testing.T) {
+	t.Parallel()
+
+	info, err := ReadInfo(Zoho)
+	if err != nil {
+		t.Fatalf("expected no errors, got: (%v)", err)
+	}
+
+	if info.BaseURL != "https://www.zohoapis.com" {
+		t.Fatalf("expected default region in base URL, got: (%v)", info.BaseURL)
+	}
+
+	if strings.Contains(info.Oauth2Opts.TokenURL, "{{") {
+		t.Fatalf("expected substituted token URL, got: (%v)", info.Oauth2Opts.TokenURL)
+	}
+
+	catalog, err := ReadCatalog()
+	if err != nil {
+		t.Fatalf("expected no errors, got: (%v)", err)
+	}
+
+	zoho := catalog.Catalog[Zoho]
+	if strings.Contains(zoho.BaseURL, "{{") || strings.Contains(zoho.Oauth2Opts.TokenURL, "{{") {
+		t.Fatalf("expected catalog without templates, got: (%v, %v)", zoho.BaseURL, zoho.Oauth2Opts.TokenURL)
+	}
+
+	// Workspace is unknown, only the region is substituted.
+	salesforce, err := ReadInfo(Salesforce)
+	if err != nil {
+		t.Fatalf("expected no errors, got: (%v)", err)
+	}
+
+	if salesforce.BaseURL != "https://{{.workspace}}.my.salesforce.com" {
+		t.Fatalf("expected default region with workspace template, got: (%v)", salesforce.BaseURL)
+	}
+}
@@ -3,15 +3,25 @@ package providers
 const Salesforce Provider = "salesforce"
 
 func init() {
+	// Sandbox orgs have a separate My Domain host.
+	// https://help.salesforce.com/s/articleView?id=sf.domain_name_formats.htm
+	SetRegions(Salesforce, RegionOpts{
+		Default: "production",
+		Hosts: map[string]string{
+			"production": "my",
+			"sandbox":    "sandbox.my",
+		},
+	})
+
 	// Salesforce configuration
 	SetInfo(Salesforce, ProviderInfo{
 		DisplayName: "Salesforce",
 		AuthType:    Oauth2,
-		BaseURL:     "https://{{.workspace}}.my.salesforce.com",
+		BaseURL:     "https://{{.workspace}}.{{.region}}.salesforce.com",
 		Oauth2Opts: &Oauth2Opts{
 			GrantType:                 AuthorizationCode,
-			AuthURL:                   "https://{{.workspace}}.my.salesforce.com/services/oauth2/authorize",
-			TokenURL:                  "https://{{.workspace}}.my.salesforce.com/services/oauth2/token",
+			AuthURL:                   "https://{{.workspace}}.{{.region}}.salesforce.com/services/oauth2/authorize",
+			TokenURL:                  "https://{{.workspace}}.{{.region}}.salesforce.com/services/oauth2/token",
 			ExplicitScopesRequired:    false,
 			ExplicitWorkspaceRequired: true,
 			TokenMetadataFields: TokenMetadataFields{
@@ -0,0 +1,74 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Records are read in batches of 2000 by default.
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/headers_queryoptions.htm
+const defaultQueryBatchSize = 2000
+
+// describeGlobalResult lists objects of the organization along with permissions of the current user.
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_describeGlobal.htm
+type describeGlobalResult struct {
+	SObjects []describeGlobalObject `json:"sobjects"`
+}
+
+type describeGlobalObject struct {
+	Name       string `json:"name"`
+	Queryable  bool   `json:"queryable"`
+	Createable bool   `json:"createable"`
+	Updateable bool   `json:"updateable"`
+	// Replicateable objects track modifications, which allows to query records changed since a moment.
+	Replicateable bool `json:"replicateable"`
+}
+
+// Capabilities lists objects of the organization as reported by the Describe Global resource.
+// Permissions of the connected user decide which objects can be read, created or updated.
+// Read supports SOQL Filter. Since and Deleted are applied to replicateable objects.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	url, err := c.getRestApiURL("sobjects")
+	if err != nil {
+		return nil, err
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := common.UnmarshalJSON[describeGlobalResult](rsp)
+	if err != nil {
+		return nil, err
+	}
+
+	capabilities := common.NewCapabilities()
+
+	if result == nil {
+		return capabilities, nil
+	}
+
+	for _, object := range result.SObjects {
+		if object.Queryable {
+			capabilities.WithRead(common.ReadCapabilities{
+				Incremental: object.Replicateable,
+				Deleted:     object.Replicateable,
+				Filter:      true,
+				Pagination:  common.PaginationNextURL,
+				MaxPageSize: defaultQueryBatchSize,
+			}, object.Name)
+		}
+
+		if object.Createable {
+			capabilities.WithOperation(common.OperationCreate, object.Name)
+		}
+
+		if object.Updateable {
+			capabilities.WithOperation(common.OperationUpdate, object.Name)
+		}
+	}
+
+	return capabilities, nil
+}
@@ -0,0 +1,63 @@
+package salesforce
+
+import (
+	"context"
+	"net/http"
+	"reflect"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestCapabilities(t *testing.T) {
+	t.Parallel()
+
+	responseDescribeGlobal := testutils.DataFromFile(t, "capabilities/describe-global.json")
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If:    mockcond.PathSuffix("/services/data/v59.0/sobjects"),
+		Then:  mockserver.Response(http.StatusOK, responseDescribeGlobal),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	capabilities, err := connector.Capabilities(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error %v", err)
+	}
+
+	expected := map[string]common.ObjectCapabilities{
+		"Account": {
+			Read:   true,
+			Create: true,
+			Update: true,
+			ReadCapabilities: common.ReadCapabilities{
+				Incremental: true,
+				Deleted:     true,
+				Filter:      true,
+				Pagination:  common.PaginationNextURL,
+				MaxPageSize: 2000,
+			},
+		},
+		"AccountHistory": {
+			Read: true,
+			ReadCapabilities: common.ReadCapabilities{
+				Filter:      true,
+				Pagination:  common.PaginationNextURL,
+				MaxPageSize: 2000,
+			},
+		},
+	}
+
+	if !reflect.DeepEqual(capabilities.Objects, expected) {
+		t.Fatalf("expected: (%v), got: (%v)", expected, capabilities.Objects)
+	}
+}
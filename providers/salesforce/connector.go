@@ -1,6 +1,8 @@
 package salesforce
 
 import (
+	"context"
+
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/interpreter"
 	"github.com/amp-labs/connectors/common/paramsbuilder"
@@ -22,6 +24,11 @@ type Connector struct {
 	BaseURL   string
 	Client    *common.JSONHTTPClient
 	XMLClient *common.XMLHTTPClient
+
+	usage *usageGauge
+
+	// metadataAllOrNone is sent as AllOrNoneHeader of Metadata API calls.
+	metadataAllOrNone bool
 }
 
 func APIVersionSOAP() string {
@@ -40,17 +47,31 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		return nil, err
 	}
 
-	httpClient := params.Client.Caller
 	conn = &Connector{
-		Client: &common.JSONHTTPClient{
-			HTTPClient: httpClient,
-		},
-		XMLClient: &common.XMLHTTPClient{
-			HTTPClient: httpClient,
+		usage:             newUsageGauge(params.usageThreshold, params.limitsRefreshInterval),
+		metadataAllOrNone: params.metadataAllOrNone,
+	}
+
+	// Every request is accounted against org's daily quota.
+	params.Client.Caller.Client = &usageTrackingClient{
+		AuthenticatedHTTPClient: params.Client.Caller.Client,
+		gauge:                   conn.usage,
+		refresh: func(ctx context.Context) error {
+			_, err := conn.Limits(ctx)
+
+			return err
 		},
 	}
 
-	providerInfo, err := providers.ReadInfo(conn.Provider(), &params.Workspace)
+	httpClient := params.Client.Caller
+	conn.Client = &common.JSONHTTPClient{
+		HTTPClient: httpClient,
+	}
+	conn.XMLClient = &common.XMLHTTPClient{
+		HTTPClient: httpClient,
+	}
+
+	providerInfo, err := providers.ReadInfo(conn.Provider(), &params.Workspace, &params.Region)
 	if err != nil {
 		return nil, err
 	}
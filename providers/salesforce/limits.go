@@ -6,6 +6,7 @@ import (
 	"github.com/amp-labs/connectors/common"
 )
 
+// Limits returns org's quotas. The response also refreshes the usage reported by APIUsage.
 func (c *Connector) Limits(ctx context.Context) (*LimitsResponse, error) {
 	url, err := c.getRestApiURL("limits")
 	if err != nil {
@@ -17,7 +18,14 @@ func (c *Connector) Limits(ctx context.Context) (*LimitsResponse, error) {
 		return nil, err
 	}
 
-	return common.UnmarshalJSON[LimitsResponse](response)
+	limits, err := common.UnmarshalJSON[LimitsResponse](response)
+	if err != nil {
+		return nil, err
+	}
+
+	c.usage.recordLimits(limits)
+
+	return limits, nil
 }
 
 // nolint:tagliatelle
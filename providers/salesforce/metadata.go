@@ -85,7 +85,8 @@ func constructResponseMap(response *common.JSONHTTPResponse) (*common.ListObject
 			objectsMap.Result[strings.ToLower(result.Name)] = common.ObjectMetadata{
 				DisplayName: result.Label,
 				// Map that satisfies type constraint
-				FieldsMap: makeFieldsMap(result.Fields),
+				FieldsMap:  makeFieldsMap(result.Fields),
+				FieldTypes: makeFieldTypes(result.Fields),
 			}
 		}
 	}
@@ -107,6 +108,40 @@ func makeFieldsMap(fields []fieldResult) map[string]string {
 	return fieldsMap
 }
 
+// makeFieldTypes constructs a map of field names to value types from a describeSObjectResult.
+func makeFieldTypes(fields []fieldResult) common.FieldTypes {
+	fieldTypes := make(common.FieldTypes)
+
+	for _, field := range fields {
+		fieldTypes[strings.ToLower(field.Name)] = valueType(field.Type)
+	}
+
+	return fieldTypes
+}
+
+// valueType converts SOAP type of the field into common.ValueType.
+// https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/field_types.htm
+func valueType(fieldType string) common.ValueType {
+	switch fieldType {
+	case "boolean":
+		return common.ValueTypeBoolean
+	case "int", "long":
+		return common.ValueTypeInt
+	case "double", "currency", "percent":
+		return common.ValueTypeFloat
+	case "date":
+		return common.ValueTypeDate
+	case "datetime":
+		return common.ValueTypeDateTime
+	case "string", "textarea", "id", "reference", "picklist", "multipicklist", "combobox",
+		"email", "phone", "url", "encryptedstring", "time":
+		return common.ValueTypeString
+	default:
+		// Compound fields, ex: address or location.
+		return common.ValueTypeOther
+	}
+}
+
 type compositeRequest struct {
 	AllOrNone        bool                   `json:"allOrNone"`
 	CompositeRequest []compositeRequestItem `json:"compositeRequest"`
@@ -148,4 +183,5 @@ type describeSObjectResult struct {
 type fieldResult struct {
 	Name  string `json:"name"`
 	Label string `json:"label"`
+	Type  string `json:"type"`
 }
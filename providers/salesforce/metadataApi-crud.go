@@ -0,0 +1,212 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/xml"
+	"errors"
+)
+
+// Metadata API processes at most 10 components per CRUD call.
+// Larger inputs are split into several calls.
+// https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/meta_createMetadata.htm
+const metadataBatchSize = 10
+
+var ErrMissingMetadata = errors.New("no metadata components were provided")
+
+// CreateMetadataComponents creates any metadata components, ex: CustomObject, CustomField.
+// Every component has its own SaveResult, failure of one doesn't return an error
+// and doesn't affect others, unless the connector is created WithMetadataAllOrNone.
+// Then a failure of one component rolls back every component of the same call, at most 10 of them.
+func (c *Connector) CreateMetadataComponents(
+	ctx context.Context, components ...MetadataComponent,
+) ([]SaveResult, error) {
+	return saveMetadata[SaveResult](ctx, c, "createMetadata", components)
+}
+
+// UpdateMetadataComponents updates existing metadata components, identified by FullName.
+// Failures are reported the same way as by CreateMetadataComponents.
+func (c *Connector) UpdateMetadataComponents(
+	ctx context.Context, components ...MetadataComponent,
+) ([]SaveResult, error) {
+	return saveMetadata[SaveResult](ctx, c, "updateMetadata", components)
+}
+
+// UpsertMetadataComponents creates components or updates them if they already exist.
+// Failures are reported the same way as by CreateMetadataComponents.
+func (c *Connector) UpsertMetadataComponents(
+	ctx context.Context, components ...MetadataComponent,
+) ([]UpsertResult, error) {
+	return saveMetadata[UpsertResult](ctx, c, "upsertMetadata", components)
+}
+
+// DeleteMetadataComponents removes components of the same type by their full names.
+func (c *Connector) DeleteMetadataComponents(
+	ctx context.Context, metadataType MetadataType, fullNames ...string,
+) ([]DeleteResult, error) {
+	if len(fullNames) == 0 {
+		return nil, ErrMissingMetadata
+	}
+
+	results := make([]DeleteResult, 0, len(fullNames))
+
+	for _, batch := range splitIntoBatches(fullNames) {
+		var response soapResponse[[]DeleteResult]
+		if err := c.callMetadataAPI(ctx, namedComponentsRequest{
+			XMLName:   xml.Name{Local: "deleteMetadata"},
+			Type:      metadataType,
+			FullNames: batch,
+		}, &response); err != nil {
+			return nil, err
+		}
+
+		results = append(results, response.Body.Response.Result...)
+	}
+
+	return results, nil
+}
+
+// CreateCustomObjects is a typed version of CreateMetadataComponents.
+func (c *Connector) CreateCustomObjects(ctx context.Context, objects ...CustomObject) ([]SaveResult, error) {
+	return c.CreateMetadataComponents(ctx, asComponents(objects)...)
+}
+
+// UpdateCustomObjects is a typed version of UpdateMetadataComponents.
+func (c *Connector) UpdateCustomObjects(ctx context.Context, objects ...CustomObject) ([]SaveResult, error) {
+	return c.UpdateMetadataComponents(ctx, asComponents(objects)...)
+}
+
+// DeleteCustomObjects removes custom objects by full names, ex: "TestObject__c".
+func (c *Connector) DeleteCustomObjects(ctx context.Context, fullNames ...string) ([]DeleteResult, error) {
+	return c.DeleteMetadataComponents(ctx, MetadataTypeCustomObject, fullNames...)
+}
+
+// ReadCustomObjects returns definitions of custom objects by full names.
+// Objects that do not exist are omitted from the result.
+func (c *Connector) ReadCustomObjects(ctx context.Context, fullNames ...string) ([]CustomObject, error) {
+	return readMetadata[CustomObject](ctx, c, MetadataTypeCustomObject, fullNames)
+}
+
+// CreateCustomFields is a typed version of CreateMetadataComponents.
+// Field full name must include object name, ex: "Account.Comments__c".
+func (c *Connector) CreateCustomFields(ctx context.Context, fields ...CustomField) ([]SaveResult, error) {
+	return c.CreateMetadataComponents(ctx, asComponents(fields)...)
+}
+
+// UpdateCustomFields is a typed version of UpdateMetadataComponents.
+func (c *Connector) UpdateCustomFields(ctx context.Context, fields ...CustomField) ([]SaveResult, error) {
+	return c.UpdateMetadataComponents(ctx, asComponents(fields)...)
+}
+
+// DeleteCustomFields removes custom fields by full names, ex: "Account.Comments__c".
+func (c *Connector) DeleteCustomFields(ctx context.Context, fullNames ...string) ([]DeleteResult, error) {
+	return c.DeleteMetadataComponents(ctx, MetadataTypeCustomField, fullNames...)
+}
+
+// ReadCustomFields returns definitions of custom fields by full names.
+// Fields that do not exist are omitted from the result.
+func (c *Connector) ReadCustomFields(ctx context.Context, fullNames ...string) ([]CustomField, error) {
+	return readMetadata[CustomField](ctx, c, MetadataTypeCustomField, fullNames)
+}
+
+func saveMetadata[R any](
+	ctx context.Context, conn *Connector, operation string, components []MetadataComponent,
+) ([]R, error) {
+	if len(components) == 0 {
+		return nil, ErrMissingMetadata
+	}
+
+	results := make([]R, 0, len(components))
+
+	for _, batch := range splitIntoBatches(components) {
+		elements := make([]metadataElement, len(batch))
+		for index, component := range batch {
+			elements[index] = metadataElement{component: component}
+		}
+
+		var response soapResponse[[]R]
+		if err := conn.callMetadataAPI(ctx, saveComponentsRequest{
+			XMLName:  xml.Name{Local: operation},
+			Metadata: elements,
+		}, &response); err != nil {
+			return nil, err
+		}
+
+		results = append(results, response.Body.Response.Result...)
+	}
+
+	return results, nil
+}
+
+func readMetadata[R namedComponent](
+	ctx context.Context, conn *Connector, metadataType MetadataType, fullNames []string,
+) ([]R, error) {
+	if len(fullNames) == 0 {
+		return nil, ErrMissingMetadata
+	}
+
+	results := make([]R, 0, len(fullNames))
+
+	for _, batch := range splitIntoBatches(fullNames) {
+		var response soapResponse[readResult[R]]
+		if err := conn.callMetadataAPI(ctx, namedComponentsRequest{
+			XMLName:   xml.Name{Local: "readMetadata"},
+			Type:      metadataType,
+			FullNames: batch,
+		}, &response); err != nil {
+			return nil, err
+		}
+
+		// Salesforce returns empty record for every unknown name.
+		for _, record := range response.Body.Response.Result.Records {
+			if record.getFullName() != "" {
+				results = append(results, record)
+			}
+		}
+	}
+
+	return results, nil
+}
+
+func asComponents[C MetadataComponent](list []C) []MetadataComponent {
+	components := make([]MetadataComponent, len(list))
+	for index, component := range list {
+		components[index] = component
+	}
+
+	return components
+}
+
+func splitIntoBatches[T any](list []T) [][]T {
+	batches := make([][]T, 0, len(list)/metadataBatchSize+1)
+
+	for start := 0; start < len(list); start += metadataBatchSize {
+		end := start + metadataBatchSize
+		if end > len(list) {
+			end = len(list)
+		}
+
+		batches = append(batches, list[start:end])
+	}
+
+	return batches
+}
+
+type saveComponentsRequest struct {
+	XMLName  xml.Name
+	Metadata []metadataElement `xml:"metadata"`
+}
+
+type namedComponentsRequest struct {
+	XMLName   xml.Name
+	Type      MetadataType `xml:"type"`
+	FullNames []string     `xml:"fullNames"`
+}
+
+// namedComponent is a metadata component which can be read by its full name.
+type namedComponent interface {
+	getFullName() string
+}
+
+type readResult[R any] struct {
+	Records []R `xml:"records"`
+}
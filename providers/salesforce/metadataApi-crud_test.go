@@ -0,0 +1,235 @@
+package salesforce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+	"golang.org/x/oauth2"
+)
+
+func TestCreateCustomFields(t *testing.T) { //nolint:funlen
+	t.Parallel()
+
+	responseCreate := testutils.DataFromFile(t, "metadata/create-fields-response.xml")
+
+	tests := []createCustomFieldsTestCase{
+		{
+			Name:         "At least one field is required",
+			Input:        []CustomField{},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{ErrMissingMetadata},
+		},
+		{
+			Name:  "Session header and typed fields are sent",
+			Input: []CustomField{{FullName: "Account.Comments__c", Label: "Comments", Type: "LongTextArea"}},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentXML(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/services/Soap/m/59.0"),
+					mockcond.BodyContains("<sessionId>" + testAccessToken + "</sessionId>"),
+					mockcond.BodyContains("<allOrNone>false</allOrNone>"),
+					mockcond.BodyContains(`<metadata xsi:type="CustomField"><fullName>Account.Comments__c</fullName>` +
+						`<label>Comments</label><type>LongTextArea</type></metadata>`),
+				},
+				Then: mockserver.Response(http.StatusOK, responseCreate),
+			}.Server(),
+			Expected: []SaveResult{{
+				FullName: "Account.Comments__c",
+				Success:  true,
+			}, {
+				FullName: "TestObject13__c.Comments__c",
+				Success:  false,
+				Errors: []MetadataError{{
+					StatusCode: "FIELD_INTEGRITY_EXCEPTION",
+					Message:    "Entity 'TestObject13__c' not found.",
+				}},
+			}},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestOAuthConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestCreateCustomFieldsAllOrNone(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentXML(),
+		If:    mockcond.BodyContains("<allOrNone>true</allOrNone>"),
+		Then:  mockserver.Response(http.StatusOK, testutils.DataFromFile(t, "metadata/create-fields-response.xml")),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestOAuthConnector(server.URL, WithMetadataAllOrNone())
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	_, err = connector.CreateCustomFields(context.Background(),
+		CustomField{FullName: "Account.Comments__c", Label: "Comments", Type: "LongTextArea"})
+	if err != nil {
+		t.Fatalf("all or none header must be sent, got error: %v", err)
+	}
+}
+
+func TestReadCustomFields(t *testing.T) {
+	t.Parallel()
+
+	responseRead := testutils.DataFromFile(t, "metadata/read-fields-response.xml")
+
+	tests := []readCustomFieldsTestCase{
+		{
+			Name:  "Unknown fields are omitted",
+			Input: []string{"Account.Comments__c", "Account.Unknown__c"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentXML(),
+				If: mockcond.BodyContains("<readMetadata><type>CustomField</type>" +
+					"<fullNames>Account.Comments__c</fullNames><fullNames>Account.Unknown__c</fullNames></readMetadata>"),
+				Then: mockserver.Response(http.StatusOK, responseRead),
+			}.Server(),
+			Expected: []CustomField{{
+				FullName:     "Account.Comments__c",
+				Label:        "Comments",
+				Type:         "LongTextArea",
+				Length:       500,
+				VisibleLines: 30,
+			}},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestOAuthConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestDeleteCustomFields(t *testing.T) {
+	t.Parallel()
+
+	responseDelete := testutils.DataFromFile(t, "metadata/delete-fields-response.xml")
+
+	tests := []deleteCustomFieldsTestCase{
+		{
+			Name:  "Successful delete",
+			Input: []string{"Account.Comments__c"},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentXML(),
+				If: mockcond.BodyContains("<deleteMetadata><type>CustomField</type>" +
+					"<fullNames>Account.Comments__c</fullNames></deleteMetadata>"),
+				Then: mockserver.Response(http.StatusOK, responseDelete),
+			}.Server(),
+			Expected: []DeleteResult{{
+				FullName: "Account.Comments__c",
+				Success:  true,
+			}},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestOAuthConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestMetadataSessionRequiresOAuthClient(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Dummy()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	_, err = connector.DeleteCustomFields(context.Background(), "Account.Comments__c")
+	testutils.CheckErrors(t, "Non OAuth client cannot provide session",
+		[]error{ErrMissingSessionID, common.ErrNotOAuthClient}, err)
+}
+
+const testAccessToken = "access_token_testing"
+
+func constructTestOAuthConnector(serverURL string, opts ...Option) (*Connector, error) {
+	client, err := common.NewOAuthHTTPClient(context.Background(),
+		common.WithTokenSource(oauth2.StaticTokenSource(&oauth2.Token{AccessToken: testAccessToken})),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	connector, err := NewConnector(append([]Option{
+		WithAuthenticatedClient(client),
+		WithWorkspace("test-workspace"),
+	}, opts...)...)
+	if err != nil {
+		return nil, err
+	}
+
+	// for testing we want to redirect calls to our mock server
+	connector.setBaseURL(serverURL)
+
+	return connector, nil
+}
+
+type (
+	createCustomFieldsTestCaseType = testroutines.TestCase[[]CustomField, []SaveResult]
+	createCustomFieldsTestCase     createCustomFieldsTestCaseType
+	readCustomFieldsTestCaseType   = testroutines.TestCase[[]string, []CustomField]
+	readCustomFieldsTestCase       readCustomFieldsTestCaseType
+	deleteCustomFieldsTestCaseType = testroutines.TestCase[[]string, []DeleteResult]
+	deleteCustomFieldsTestCase     deleteCustomFieldsTestCaseType
+)
+
+func (c createCustomFieldsTestCase) Run(t *testing.T, builder testroutines.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	conn := builder.Build(t, c.Name)
+	output, err := conn.CreateCustomFields(context.Background(), c.Input...)
+	createCustomFieldsTestCaseType(c).Validate(t, err, output)
+}
+
+func (c readCustomFieldsTestCase) Run(t *testing.T, builder testroutines.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	conn := builder.Build(t, c.Name)
+	output, err := conn.ReadCustomFields(context.Background(), c.Input...)
+	readCustomFieldsTestCaseType(c).Validate(t, err, output)
+}
+
+func (c deleteCustomFieldsTestCase) Run(t *testing.T, builder testroutines.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	conn := builder.Build(t, c.Name)
+	output, err := conn.DeleteCustomFields(context.Background(), c.Input...)
+	deleteCustomFieldsTestCaseType(c).Validate(t, err, output)
+}
@@ -0,0 +1,265 @@
+package salesforce
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"time"
+)
+
+// DefaultMetadataPollInterval is used when waiting for asynchronous deploy or retrieve to complete.
+const DefaultMetadataPollInterval = 5 * time.Second
+
+var ErrMissingZipFile = errors.New("zip file is required")
+
+// DeployOptions controls how the package is deployed.
+// See: https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/meta_deploy.htm#deploy_options.
+type DeployOptions struct {
+	AllowMissingFiles bool     `xml:"allowMissingFiles"`
+	CheckOnly         bool     `xml:"checkOnly"`
+	IgnoreWarnings    bool     `xml:"ignoreWarnings"`
+	PerformRetrieve   bool     `xml:"performRetrieve"`
+	PurgeOnDelete     bool     `xml:"purgeOnDelete"`
+	RollbackOnError   bool     `xml:"rollbackOnError"`
+	SinglePackage     bool     `xml:"singlePackage"`
+	TestLevel         string   `xml:"testLevel,omitempty"`
+	RunTests          []string `xml:"runTests,omitempty"`
+}
+
+// AsyncResult is returned by deploy and retrieve, it identifies the job to poll.
+type AsyncResult struct {
+	ID    string `xml:"id"`
+	Done  bool   `xml:"done"`
+	State string `xml:"state"`
+}
+
+// DeployResult is a status of deployment.
+// See: https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/meta_deployresult.htm.
+type DeployResult struct {
+	ID                       string        `xml:"id"`
+	Done                     bool          `xml:"done"`
+	Status                   string        `xml:"status"`
+	Success                  bool          `xml:"success"`
+	CheckOnly                bool          `xml:"checkOnly"`
+	ErrorMessage             string        `xml:"errorMessage"`
+	ErrorStatusCode          string        `xml:"errorStatusCode"`
+	NumberComponentErrors    int           `xml:"numberComponentErrors"`
+	NumberComponentsDeployed int           `xml:"numberComponentsDeployed"`
+	NumberComponentsTotal    int           `xml:"numberComponentsTotal"`
+	Details                  DeployDetails `xml:"details"`
+}
+
+// DeployDetails lists component level outcomes.
+type DeployDetails struct {
+	ComponentFailures  []DeployMessage `xml:"componentFailures"`
+	ComponentSuccesses []DeployMessage `xml:"componentSuccesses"`
+}
+
+// DeployMessage describes deployment of a single component.
+type DeployMessage struct {
+	ComponentType string `xml:"componentType"`
+	FullName      string `xml:"fullName"`
+	FileName      string `xml:"fileName"`
+	Success       bool   `xml:"success"`
+	Created       bool   `xml:"created"`
+	Changed       bool   `xml:"changed"`
+	Deleted       bool   `xml:"deleted"`
+	Problem       string `xml:"problem"`
+	ProblemType   string `xml:"problemType"`
+}
+
+// RetrieveRequest describes which components should be packaged into zip file.
+// See: https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/meta_retrieverequest.htm.
+type RetrieveRequest struct {
+	// APIVersion defaults to the connector's API version.
+	APIVersion    string           `xml:"apiVersion"`
+	PackageNames  []string         `xml:"packageNames,omitempty"`
+	SinglePackage bool             `xml:"singlePackage"`
+	Unpackaged    *PackageManifest `xml:"unpackaged,omitempty"`
+}
+
+// PackageManifest is the content of package.xml.
+type PackageManifest struct {
+	Types []PackageTypeMembers `xml:"types"`
+}
+
+// PackageTypeMembers lists members of one metadata type. Wildcard "*" selects all members.
+type PackageTypeMembers struct {
+	Members []string     `xml:"members"`
+	Name    MetadataType `xml:"name"`
+}
+
+// RetrieveResult is a status of retrieval, once done it holds the zip file.
+// See: https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/meta_retrieveresult.htm.
+type RetrieveResult struct {
+	ID              string            `xml:"id"`
+	Done            bool              `xml:"done"`
+	Status          string            `xml:"status"`
+	Success         bool              `xml:"success"`
+	ErrorMessage    string            `xml:"errorMessage"`
+	ErrorStatusCode string            `xml:"errorStatusCode"`
+	Messages        []RetrieveMessage `xml:"messages"`
+	// ZipFile is decoded package content.
+	ZipFile []byte `xml:"-"`
+}
+
+// RetrieveMessage is a warning produced during retrieval.
+type RetrieveMessage struct {
+	FileName string `xml:"fileName"`
+	Problem  string `xml:"problem"`
+}
+
+// Deploy starts asynchronous deployment of zip package.
+// Use CheckDeployStatus or WaitForDeploy to track its progress.
+func (c *Connector) Deploy(ctx context.Context, zipFile []byte, options DeployOptions) (*AsyncResult, error) {
+	if len(zipFile) == 0 {
+		return nil, ErrMissingZipFile
+	}
+
+	var response soapResponse[AsyncResult]
+	if err := c.callMetadataAPI(ctx, deployRequest{
+		ZipFile: base64.StdEncoding.EncodeToString(zipFile),
+		Options: options,
+	}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Body.Response.Result, nil
+}
+
+// CheckDeployStatus returns current status of deployment including component level details.
+func (c *Connector) CheckDeployStatus(ctx context.Context, asyncProcessID string) (*DeployResult, error) {
+	var response soapResponse[DeployResult]
+	if err := c.callMetadataAPI(ctx, checkDeployStatusRequest{
+		AsyncProcessID: asyncProcessID,
+		IncludeDetails: true,
+	}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Body.Response.Result, nil
+}
+
+// WaitForDeploy polls deployment status until it is done or context is cancelled.
+func (c *Connector) WaitForDeploy(
+	ctx context.Context, asyncProcessID string, interval time.Duration,
+) (*DeployResult, error) {
+	return pollUntilDone(ctx, interval, func() (*DeployResult, bool, error) {
+		result, err := c.CheckDeployStatus(ctx, asyncProcessID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return result, result.Done, nil
+	})
+}
+
+// Retrieve starts asynchronous retrieval of metadata components as zip package.
+// Use CheckRetrieveStatus or WaitForRetrieve to obtain the zip file.
+func (c *Connector) Retrieve(ctx context.Context, request RetrieveRequest) (*AsyncResult, error) {
+	if request.APIVersion == "" {
+		request.APIVersion = apiVersion
+	}
+
+	var response soapResponse[AsyncResult]
+	if err := c.callMetadataAPI(ctx, retrieveRequest{
+		Request: request,
+	}, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Body.Response.Result, nil
+}
+
+// CheckRetrieveStatus returns current status of retrieval.
+// Zip file is included once retrieval is done.
+func (c *Connector) CheckRetrieveStatus(ctx context.Context, asyncProcessID string) (*RetrieveResult, error) {
+	var response soapResponse[retrieveResult]
+	if err := c.callMetadataAPI(ctx, checkRetrieveStatusRequest{
+		AsyncProcessID: asyncProcessID,
+		IncludeZip:     true,
+	}, &response); err != nil {
+		return nil, err
+	}
+
+	result := response.Body.Response.Result
+
+	zipFile, err := base64.StdEncoding.DecodeString(result.ZipFile)
+	if err != nil {
+		return nil, errors.Join(ErrMetadataAPI, err)
+	}
+
+	result.RetrieveResult.ZipFile = zipFile
+
+	return &result.RetrieveResult, nil
+}
+
+// WaitForRetrieve polls retrieval status until it is done or context is cancelled.
+func (c *Connector) WaitForRetrieve(
+	ctx context.Context, asyncProcessID string, interval time.Duration,
+) (*RetrieveResult, error) {
+	return pollUntilDone(ctx, interval, func() (*RetrieveResult, bool, error) {
+		result, err := c.CheckRetrieveStatus(ctx, asyncProcessID)
+		if err != nil {
+			return nil, false, err
+		}
+
+		return result, result.Done, nil
+	})
+}
+
+func pollUntilDone[R any](
+	ctx context.Context, interval time.Duration, check func() (R, bool, error),
+) (R, error) {
+	if interval <= 0 {
+		interval = DefaultMetadataPollInterval
+	}
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+
+	for {
+		result, done, err := check()
+		if err != nil || done {
+			return result, err
+		}
+
+		select {
+		case <-ctx.Done():
+			var empty R
+
+			return empty, ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+type deployRequest struct {
+	XMLName xml.Name      `xml:"deploy"`
+	ZipFile string        `xml:"ZipFile"`
+	Options DeployOptions `xml:"DeployOptions"`
+}
+
+type checkDeployStatusRequest struct {
+	XMLName        xml.Name `xml:"checkDeployStatus"`
+	AsyncProcessID string   `xml:"asyncProcessId"`
+	IncludeDetails bool     `xml:"includeDetails"`
+}
+
+type retrieveRequest struct {
+	XMLName xml.Name        `xml:"retrieve"`
+	Request RetrieveRequest `xml:"retrieveRequest"`
+}
+
+type checkRetrieveStatusRequest struct {
+	XMLName        xml.Name `xml:"checkRetrieveStatus"`
+	AsyncProcessID string   `xml:"asyncProcessId"`
+	IncludeZip     bool     `xml:"includeZip"`
+}
+
+// retrieveResult holds base64 encoded zip file as it comes from the server.
+type retrieveResult struct {
+	RetrieveResult
+	ZipFile string `xml:"zipFile"`
+}
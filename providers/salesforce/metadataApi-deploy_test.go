@@ -0,0 +1,111 @@
+package salesforce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestDeployAndWait(t *testing.T) {
+	t.Parallel()
+
+	responseDeploy := testutils.DataFromFile(t, "metadata/deploy-response.xml")
+	responseStatus := testutils.DataFromFile(t, "metadata/deploy-status-response.xml")
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentXML(),
+		Cases: []mockserver.Case{{
+			If:   mockcond.BodyContains("<deploy><ZipFile>UEsDBA==</ZipFile><DeployOptions>"),
+			Then: mockserver.Response(http.StatusOK, responseDeploy),
+		}, {
+			If: mockcond.BodyContains("<checkDeployStatus><asyncProcessId>0Afak000002ET7RCAW</asyncProcessId>" +
+				"<includeDetails>true</includeDetails></checkDeployStatus>"),
+			Then: mockserver.Response(http.StatusOK, responseStatus),
+		}},
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestOAuthConnector(server.URL)
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	ctx := context.Background()
+
+	job, err := connector.Deploy(ctx, []byte("PK\x03\x04"), DeployOptions{RollbackOnError: true})
+	if err != nil {
+		t.Fatalf("deploy failed: %v", err)
+	}
+
+	if job.ID != "0Afak000002ET7RCAW" || job.Done {
+		t.Fatalf("unexpected deploy job: %v", job)
+	}
+
+	result, err := connector.WaitForDeploy(ctx, job.ID, time.Millisecond)
+	if err != nil {
+		t.Fatalf("wait for deploy failed: %v", err)
+	}
+
+	if !result.Success || result.NumberComponentsDeployed != 1 ||
+		len(result.Details.ComponentSuccesses) != 1 ||
+		result.Details.ComponentSuccesses[0].FullName != "Account.Comments__c" {
+		t.Fatalf("unexpected deploy result: %v", result)
+	}
+}
+
+func TestCheckRetrieveStatus(t *testing.T) {
+	t.Parallel()
+
+	responseStatus := testutils.DataFromFile(t, "metadata/retrieve-status-response.xml")
+
+	tests := []checkRetrieveStatusTestCase{
+		{
+			Name:  "Zip file is decoded",
+			Input: "09Sak000002ET7RCAW",
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentXML(),
+				If: mockcond.BodyContains("<checkRetrieveStatus><asyncProcessId>09Sak000002ET7RCAW</asyncProcessId>" +
+					"<includeZip>true</includeZip></checkRetrieveStatus>"),
+				Then: mockserver.Response(http.StatusOK, responseStatus),
+			}.Server(),
+			Expected: &RetrieveResult{
+				ID:      "09Sak000002ET7RCAW",
+				Done:    true,
+				Status:  "Succeeded",
+				Success: true,
+				ZipFile: []byte("PK\x03\x04\n\x00\x00\x00\x00\x00"),
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (*Connector, error) {
+				return constructTestOAuthConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+type (
+	checkRetrieveStatusTestCaseType = testroutines.TestCase[string, *RetrieveResult]
+	checkRetrieveStatusTestCase     checkRetrieveStatusTestCaseType
+)
+
+func (c checkRetrieveStatusTestCase) Run(t *testing.T, builder testroutines.ConnectorBuilder[*Connector]) {
+	t.Helper()
+	conn := builder.Build(t, c.Name)
+	output, err := conn.CheckRetrieveStatus(context.Background(), c.Input)
+	checkRetrieveStatusTestCaseType(c).Validate(t, err, output)
+}
@@ -0,0 +1,150 @@
+package salesforce
+
+import (
+	"encoding/xml"
+)
+
+// MetadataType is a name of Metadata API component type.
+// See: https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/meta_types_list.htm.
+type MetadataType string
+
+const (
+	MetadataTypeCustomObject MetadataType = "CustomObject"
+	MetadataTypeCustomField  MetadataType = "CustomField"
+)
+
+// MetadataComponent is any metadata that can be created, updated or upserted via Metadata API.
+// Implementations are serialized into <metadata xsi:type="..."> tags.
+type MetadataComponent interface {
+	MetadataType() MetadataType
+}
+
+// CustomObject describes custom object definition.
+// See: https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/customobject.htm.
+type CustomObject struct {
+	// FullName is an API name of the object, ex: "TestObject__c".
+	FullName         string        `xml:"fullName"`
+	Label            string        `xml:"label,omitempty"`
+	PluralLabel      string        `xml:"pluralLabel,omitempty"`
+	Description      string        `xml:"description,omitempty"`
+	NameField        *CustomField  `xml:"nameField,omitempty"`
+	DeploymentStatus string        `xml:"deploymentStatus,omitempty"`
+	SharingModel     string        `xml:"sharingModel,omitempty"`
+	EnableActivities bool          `xml:"enableActivities,omitempty"`
+	EnableHistory    bool          `xml:"enableHistory,omitempty"`
+	EnableReports    bool          `xml:"enableReports,omitempty"`
+	Fields           []CustomField `xml:"fields,omitempty"`
+}
+
+func (CustomObject) MetadataType() MetadataType {
+	return MetadataTypeCustomObject
+}
+
+func (o CustomObject) getFullName() string {
+	return o.FullName
+}
+
+// CustomField describes custom field definition.
+// See: https://developer.salesforce.com/docs/atlas.en-us.api_meta.meta/api_meta/customfield.htm.
+type CustomField struct {
+	// FullName is an API name of the field.
+	// When used on its own it must be prefixed with the object name, ex: "Account.Comments__c".
+	FullName         string    `xml:"fullName"`
+	Label            string    `xml:"label,omitempty"`
+	Type             string    `xml:"type,omitempty"`
+	Description      string    `xml:"description,omitempty"`
+	InlineHelpText   string    `xml:"inlineHelpText,omitempty"`
+	DefaultValue     string    `xml:"defaultValue,omitempty"`
+	Length           int       `xml:"length,omitempty"`
+	Precision        int       `xml:"precision,omitempty"`
+	Scale            int       `xml:"scale,omitempty"`
+	VisibleLines     int       `xml:"visibleLines,omitempty"`
+	Required         bool      `xml:"required,omitempty"`
+	Unique           bool      `xml:"unique,omitempty"`
+	ExternalID       bool      `xml:"externalId,omitempty"`
+	ReferenceTo      string    `xml:"referenceTo,omitempty"`
+	RelationshipName string    `xml:"relationshipName,omitempty"`
+	TrackFeedHistory bool      `xml:"trackFeedHistory,omitempty"`
+	TrackHistory     bool      `xml:"trackHistory,omitempty"`
+	ValueSet         *ValueSet `xml:"valueSet,omitempty"`
+}
+
+func (CustomField) MetadataType() MetadataType {
+	return MetadataTypeCustomField
+}
+
+func (f CustomField) getFullName() string {
+	return f.FullName
+}
+
+// ValueSet holds values of a picklist field.
+type ValueSet struct {
+	Restricted         bool                `xml:"restricted,omitempty"`
+	ValueSetDefinition *ValueSetDefinition `xml:"valueSetDefinition,omitempty"`
+	ValueSetName       string              `xml:"valueSetName,omitempty"`
+	ControllingField   string              `xml:"controllingField,omitempty"`
+	ValueSettings      []ValueSettings     `xml:"valueSettings,omitempty"`
+}
+
+// ValueSetDefinition is a list of picklist values local to the field.
+type ValueSetDefinition struct {
+	Sorted bool          `xml:"sorted,omitempty"`
+	Values []CustomValue `xml:"value"`
+}
+
+// CustomValue is a single picklist value.
+type CustomValue struct {
+	FullName string `xml:"fullName"`
+	Label    string `xml:"label,omitempty"`
+	Default  bool   `xml:"default"`
+}
+
+// ValueSettings maps dependent picklist value to the controlling field values.
+type ValueSettings struct {
+	ControllingFieldValue []string `xml:"controllingFieldValue"`
+	ValueName             string   `xml:"valueName"`
+}
+
+// MetadataError is a reason why operation on a component has failed.
+type MetadataError struct {
+	StatusCode string   `xml:"statusCode"`
+	Message    string   `xml:"message"`
+	Fields     []string `xml:"fields"`
+}
+
+// SaveResult is an outcome of create or update operation per component.
+type SaveResult struct {
+	FullName string          `xml:"fullName"`
+	Success  bool            `xml:"success"`
+	Errors   []MetadataError `xml:"errors"`
+}
+
+// UpsertResult is an outcome of upsert operation per component.
+type UpsertResult struct {
+	FullName string          `xml:"fullName"`
+	Success  bool            `xml:"success"`
+	Created  bool            `xml:"created"`
+	Errors   []MetadataError `xml:"errors"`
+}
+
+// DeleteResult is an outcome of delete operation per component.
+type DeleteResult struct {
+	FullName string          `xml:"fullName"`
+	Success  bool            `xml:"success"`
+	Errors   []MetadataError `xml:"errors"`
+}
+
+// metadataElement serializes component as <metadata xsi:type="...">.
+type metadataElement struct {
+	component MetadataComponent
+}
+
+func (m metadataElement) MarshalXML(encoder *xml.Encoder, start xml.StartElement) error {
+	start.Name = xml.Name{Local: "metadata"}
+	start.Attr = append(start.Attr, xml.Attr{
+		Name:  xml.Name{Local: "xsi:type"},
+		Value: string(m.component.MetadataType()),
+	})
+
+	return encoder.EncodeElement(m.component, start)
+}
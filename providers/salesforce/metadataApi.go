@@ -2,25 +2,35 @@ package salesforce
 
 import (
 	"context"
+	"encoding/xml"
 	"errors"
+	"fmt"
+	"strconv"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/xquery"
 )
 
-var ErrCreateMetadata = errors.New("error in CreateMetadata")
+var (
+	ErrCreateMetadata   = errors.New("error in CreateMetadata")
+	ErrMetadataAPI      = errors.New("error in Metadata API call")
+	ErrMissingSessionID = errors.New("cannot derive session id, connector must use OAuth client")
+)
 
 // CreateMetadata creates custom metadata.
 // Requires non-expired access token to be passed directly.
 // According to documentation every XML type of request must include SessionHeader with access token.
 // See: https://developer.salesforce.com/docs/atlas.en-us.api.meta/api/sforce_api_header_sessionheader.htm.
+//
+// Prefer typed operations such as CreateCustomObjects or CreateCustomFields,
+// which derive the session from the connector's OAuth client.
 func (c *Connector) CreateMetadata(ctx context.Context, data []byte, accessToken string) (string, error) {
 	body, err := xquery.NewXML(data)
 	if err != nil {
 		return "", err
 	}
 
-	body, err = putInsideEnvelope(body, accessToken)
+	body, err = putInsideEnvelope(body, accessToken, true)
 	if err != nil {
 		return "", err
 	}
@@ -38,7 +48,72 @@ func (c *Connector) CreateMetadata(ctx context.Context, data []byte, accessToken
 	return resp.Body.RawXML(), nil
 }
 
-func putInsideEnvelope(content *xquery.XML, accessToken string) (*xquery.XML, error) {
+// callMetadataAPI sends Metadata API SOAP request.
+// The payload is marshalled into XML and placed inside SOAP envelope.
+// SessionHeader is populated with the access token of the connector's own OAuth client.
+// AllOrNoneHeader is set according to WithMetadataAllOrNone option.
+// Response body content is unmarshalled into the output.
+func (c *Connector) callMetadataAPI(ctx context.Context, payload any, output any) error {
+	data, err := xml.Marshal(payload)
+	if err != nil {
+		return errors.Join(ErrMetadataAPI, err)
+	}
+
+	body, err := xquery.NewXML(data)
+	if err != nil {
+		return errors.Join(ErrMetadataAPI, err)
+	}
+
+	sessionID, err := c.sessionID(ctx)
+	if err != nil {
+		return err
+	}
+
+	body, err = putInsideEnvelope(body, sessionID, c.metadataAllOrNone)
+	if err != nil {
+		return err
+	}
+
+	url, err := c.getSoapURL()
+	if err != nil {
+		return err
+	}
+
+	resp, err := c.XMLClient.Post(ctx, url.String(), body, getSOAPHeaders()...)
+	if err != nil {
+		return errors.Join(ErrMetadataAPI, err)
+	}
+
+	if err = xml.Unmarshal([]byte(resp.Body.RawXML()), output); err != nil {
+		return errors.Join(ErrMetadataAPI, common.ErrFailedToUnmarshalBody, err)
+	}
+
+	return nil
+}
+
+// sessionID returns an access token, which Metadata API expects inside SessionHeader.
+// The token is taken from the OAuth client, therefore it is always fresh.
+func (c *Connector) sessionID(ctx context.Context) (string, error) {
+	token, err := common.OAuthTokenFromClient(ctx, unwrapClient(c.XMLClient.HTTPClient.Client))
+	if err != nil {
+		return "", fmt.Errorf("%w: %w", ErrMissingSessionID, err)
+	}
+
+	return token.AccessToken, nil
+}
+
+// soapResponse is a generic SOAP envelope returned by Metadata API.
+// Body holds a single operation response element, for example <createMetadataResponse>,
+// whose <result> tags are unmarshalled into the Result.
+type soapResponse[R any] struct {
+	Body struct {
+		Response struct {
+			Result R `xml:"result"`
+		} `xml:",any"`
+	} `xml:"Body"`
+}
+
+func putInsideEnvelope(content *xquery.XML, accessToken string, allOrNone bool) (*xquery.XML, error) {
 	template := `
 <?xml version="1.0" encoding="UTF-8"?>
 <soapenv:Envelope xmlns:soapenv="http://schemas.xmlsoap.org/soap/envelope/" xmlns:xsd="http://www.w3.org/2001/XMLSchema"
@@ -62,6 +137,7 @@ func putInsideEnvelope(content *xquery.XML, accessToken string) (*xquery.XML, er
 
 	session := envelope.FindOne("//sessionId").GetChild()
 	session.SetDataText(accessToken)
+	envelope.FindOne("//allOrNone").GetChild().SetDataText(strconv.FormatBool(allOrNone))
 	// Store user passed data within body tag.
 	envelope.FindOne("//soapenv:Body").SetDataNode(content)
 
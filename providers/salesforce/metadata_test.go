@@ -104,6 +104,62 @@ func TestListObjectMetadata(t *testing.T) { // nolint:funlen,gocognit,cyclop
 							"fiscalyearstartmonth":                   "Fiscal Year Starts In",
 							"defaultcontactaccess":                   "Default Contact Access",
 						},
+						FieldTypes: common.FieldTypes{
+							"id":                                     common.ValueTypeString,
+							"name":                                   common.ValueTypeString,
+							"division":                               common.ValueTypeString,
+							"street":                                 common.ValueTypeString,
+							"city":                                   common.ValueTypeString,
+							"state":                                  common.ValueTypeString,
+							"postalcode":                             common.ValueTypeString,
+							"country":                                common.ValueTypeString,
+							"latitude":                               common.ValueTypeFloat,
+							"longitude":                              common.ValueTypeFloat,
+							"geocodeaccuracy":                        common.ValueTypeString,
+							"address":                                common.ValueTypeOther,
+							"phone":                                  common.ValueTypeString,
+							"fax":                                    common.ValueTypeString,
+							"primarycontact":                         common.ValueTypeString,
+							"defaultlocalesidkey":                    common.ValueTypeString,
+							"timezonesidkey":                         common.ValueTypeString,
+							"languagelocalekey":                      common.ValueTypeString,
+							"receivesinfoemails":                     common.ValueTypeBoolean,
+							"receivesadmininfoemails":                common.ValueTypeBoolean,
+							"preferencesrequireopportunityproducts":  common.ValueTypeBoolean,
+							"preferencestransactionsecuritypolicy":   common.ValueTypeBoolean,
+							"preferencesconsentmanagementenabled":    common.ValueTypeBoolean,
+							"preferencesautoselectindividualonmerge": common.ValueTypeBoolean,
+							"preferenceslightningloginenabled":       common.ValueTypeBoolean,
+							"preferencesonlyllpermuserallowed":       common.ValueTypeBoolean,
+							"fiscalyearstartmonth":                   common.ValueTypeInt,
+							"usesstartdateasfiscalyearname":          common.ValueTypeBoolean,
+							"defaultaccountaccess":                   common.ValueTypeString,
+							"defaultcontactaccess":                   common.ValueTypeString,
+							"defaultopportunityaccess":               common.ValueTypeString,
+							"defaultleadaccess":                      common.ValueTypeString,
+							"defaultcaseaccess":                      common.ValueTypeString,
+							"defaultcalendaraccess":                  common.ValueTypeString,
+							"defaultpricebookaccess":                 common.ValueTypeString,
+							"defaultcampaignaccess":                  common.ValueTypeString,
+							"systemmodstamp":                         common.ValueTypeDateTime,
+							"compliancebccemail":                     common.ValueTypeString,
+							"uiskin":                                 common.ValueTypeString,
+							"signupcountryisocode":                   common.ValueTypeString,
+							"trialexpirationdate":                    common.ValueTypeDateTime,
+							"numknowledgeservice":                    common.ValueTypeInt,
+							"organizationtype":                       common.ValueTypeString,
+							"namespaceprefix":                        common.ValueTypeString,
+							"instancename":                           common.ValueTypeString,
+							"issandbox":                              common.ValueTypeBoolean,
+							"webtocasedefaultorigin":                 common.ValueTypeString,
+							"monthlypageviewsused":                   common.ValueTypeInt,
+							"monthlypageviewsentitlement":            common.ValueTypeInt,
+							"isreadonly":                             common.ValueTypeBoolean,
+							"createddate":                            common.ValueTypeDateTime,
+							"createdbyid":                            common.ValueTypeString,
+							"lastmodifieddate":                       common.ValueTypeDateTime,
+							"lastmodifiedbyid":                       common.ValueTypeString,
+						},
 					},
 				},
 				Errors: map[string]error{},
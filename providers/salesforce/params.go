@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"net/http"
+	"time"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/paramsbuilder"
@@ -17,12 +18,24 @@ type Option = func(params *parameters)
 type parameters struct {
 	paramsbuilder.Client
 	paramsbuilder.Workspace
+	paramsbuilder.Region
+
+	usageThreshold        float64
+	limitsRefreshInterval time.Duration
+	metadataAllOrNone     bool
 }
 
 func (p parameters) ValidateParams() error {
+	var thresholdErr error
+	if p.usageThreshold < 0 || p.usageThreshold > 1 {
+		thresholdErr = ErrInvalidUsageThreshold
+	}
+
 	return errors.Join(
 		p.Client.ValidateParams(),
 		p.Workspace.ValidateParams(),
+		p.Region.ValidateParams(),
+		thresholdErr,
 	)
 }
 
@@ -45,3 +58,38 @@ func WithWorkspace(workspaceRef string) Option {
 		params.WithWorkspace(workspaceRef)
 	}
 }
+
+// WithRegion selects org environment, either "production" or "sandbox". Default is "production".
+func WithRegion(region string) Option {
+	return func(params *parameters) {
+		params.WithRegion(region)
+	}
+}
+
+// WithAPIUsageThreshold makes the connector fail fast with common.ErrLimitExceeded
+// once the ratio of used daily API requests reaches the threshold, ex: 0.9 stops at 90% of quota.
+// Usage is learned from the Sforce-Limit-Info response header and from the limits endpoint.
+// Limits are refreshed before rejecting a request, so the connector recovers once quota is renewed.
+// Zero, which is the default, disables the check.
+func WithAPIUsageThreshold(ratio float64) Option {
+	return func(params *parameters) {
+		params.usageThreshold = ratio
+	}
+}
+
+// WithMetadataAllOrNone makes Metadata API calls, ex: CreateCustomFields, atomic.
+// When any component of a call fails, the whole call is rolled back.
+// By default, components are saved independently and failures are reported by their results.
+func WithMetadataAllOrNone() Option {
+	return func(params *parameters) {
+		params.metadataAllOrNone = true
+	}
+}
+
+// WithLimitsRefreshInterval enables periodic refresh of org's limits, including bulk and streaming quotas.
+// Refresh happens before the next request once the interval has passed.
+func WithLimitsRefreshInterval(interval time.Duration) Option {
+	return func(params *parameters) {
+		params.limitsRefreshInterval = interval
+	}
+}
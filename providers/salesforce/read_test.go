@@ -3,12 +3,14 @@ package salesforce
 import (
 	"errors"
 	"net/http"
+	"reflect"
 	"testing"
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/jsonquery"
 	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
 	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
 	"github.com/amp-labs/connectors/test/utils/testroutines"
 	"github.com/amp-labs/connectors/test/utils/testutils"
@@ -134,6 +136,63 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 			},
 			ExpectedErrs: nil,
 		},
+		{
+			Name: "Fields differing by case are keyed as requested",
+			Input: common.ReadParams{
+				ObjectName:        "Account",
+				Fields:            connectors.Fields("Foo__c", "foo__c"),
+				PreserveFieldCase: true,
+			},
+			Server: mockserver.Fixed{
+				Setup: mockserver.ContentJSON(),
+				Always: mockserver.ResponseString(http.StatusOK, `{
+					"totalSize": 1,
+					"done": true,
+					"records": [{"attributes": {"type": "Account"}, "Foo__c": "custom", "foo__c": "packaged"}]
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return reflect.DeepEqual(actual.Data[0].Fields, expected.Data[0].Fields)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"Foo__c": "custom", "foo__c": "packaged"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Field of related object is selected by JSONPath",
+			Input: common.ReadParams{
+				ObjectName: "Opportunity",
+				Fields:     connectors.Fields("$.Owner.Name"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.QueryParam("q", "SELECT Owner.Name FROM Opportunity"),
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"totalSize": 1,
+					"done": true,
+					"records": [{
+						"attributes": {"type": "Opportunity"},
+						"Owner": {"attributes": {"type": "User"}, "Name": "Ada Lovelace"}
+					}]
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected)
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"$.owner.name": "Ada Lovelace"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
 	}
 
 	for _, tt := range tests {
@@ -149,11 +208,11 @@ func TestRead(t *testing.T) { //nolint:funlen,gocognit,cyclop,maintidx
 	}
 }
 
-func constructTestConnector(serverURL string) (*Connector, error) {
-	connector, err := NewConnector(
+func constructTestConnector(serverURL string, opts ...Option) (*Connector, error) {
+	connector, err := NewConnector(append([]Option{
 		WithAuthenticatedClient(http.DefaultClient),
 		WithWorkspace("test-workspace"),
-	)
+	}, opts...)...)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,76 @@
+package salesforce
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// compositeReadSize is the number of records Salesforce returns per composite request.
+const compositeReadSize = 2000
+
+type compositeReadPayload struct {
+	Ids    []string `json:"ids"`
+	Fields []string `json:"fields"`
+}
+
+// GetRecordByID returns a record of the object with the given ID.
+func (c *Connector) GetRecordByID(ctx context.Context, params common.GetRecordParams) (*common.ReadResultRow, error) {
+	return common.GetRecordViaBatch(ctx, params, c.GetRecords)
+}
+
+// GetRecords returns records of the object using the sObject Collections endpoint.
+// Records which don't exist are returned by Salesforce as null and are omitted.
+// nolint:lll
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/resources_composite_sobjects_collections_retrieve.htm
+func (c *Connector) GetRecords(ctx context.Context, params common.GetRecordsParams) ([]common.ReadResultRow, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	url, err := c.getRestApiURL("composite/sobjects", params.ObjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := params.Fields.List()
+	rows := make([]common.ReadResultRow, 0, len(params.RecordIds))
+
+	for _, batch := range params.Batches(compositeReadSize) {
+		rsp, err := c.Client.Post(ctx, url.String(), compositeReadPayload{
+			Ids:    batch,
+			Fields: fields,
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		records, err := common.UnmarshalJSON[[]map[string]any](rsp)
+		if err != nil {
+			return nil, err
+		}
+
+		found := make([]map[string]any, 0, len(*records))
+
+		for _, record := range *records {
+			if record != nil {
+				found = append(found, record)
+			}
+		}
+
+		data, err := common.GetMarshaledData(found, fields)
+		if err != nil {
+			return nil, err
+		}
+
+		if params.PreserveFieldCase {
+			if err = common.ApplyPreservedFieldCase(found, data, common.GetMarshaledData, fields); err != nil {
+				return nil, err
+			}
+		}
+
+		rows = append(rows, data...)
+	}
+
+	return rows, nil
+}
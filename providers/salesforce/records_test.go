@@ -0,0 +1,124 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestGetRecords(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.GetRecords{
+		{
+			Name:         "Fields are required",
+			Input:        common.GetRecordsParams{ObjectName: "Contact", RecordIds: []string{"003ak000004nJ3FAAU"}},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrMissingFields},
+		},
+		{
+			Name: "Records missing from collection are omitted",
+			Input: common.GetRecordsParams{
+				ObjectName: "Contact",
+				RecordIds:  []string{"003ak000004nJ3FAAU", "003ak000004nJ3GAAU"},
+				Fields:     connectors.Fields("Email"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.MethodPOST(),
+					mockcond.PathSuffix("/services/data/v59.0/composite/sobjects/Contact"),
+					mockcond.Body(`{"ids":["003ak000004nJ3FAAU","003ak000004nJ3GAAU"],"fields":["Email"]}`),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `[{
+					"attributes": {"type": "Contact", "url": "/services/data/v59.0/sobjects/Contact/003ak000004nJ3FAAU"},
+					"Email": "ada@example.com",
+					"Id": "003ak000004nJ3FAAU"
+				}, null]`),
+			}.Server(),
+			Expected: []common.ReadResultRow{{
+				Fields: map[string]any{"email": "ada@example.com"},
+				Raw: map[string]any{
+					"attributes": map[string]any{
+						"type": "Contact",
+						"url":  "/services/data/v59.0/sobjects/Contact/003ak000004nJ3FAAU",
+					},
+					"Email": "ada@example.com",
+					"Id":    "003ak000004nJ3FAAU",
+				},
+			}},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Field case is preserved",
+			Input: common.GetRecordsParams{
+				ObjectName:        "Contact",
+				RecordIds:         []string{"003ak000004nJ3FAAU"},
+				Fields:            connectors.Fields("Email"),
+				PreserveFieldCase: true,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/services/data/v59.0/composite/sobjects/Contact"),
+				Then: mockserver.ResponseString(http.StatusOK, `[{
+					"attributes": {"type": "Contact"},
+					"Email": "ada@example.com",
+					"Id": "003ak000004nJ3FAAU"
+				}]`),
+			}.Server(),
+			Expected: []common.ReadResultRow{{
+				Fields: map[string]any{"Email": "ada@example.com"},
+				Raw: map[string]any{
+					"attributes": map[string]any{"type": "Contact"},
+					"Email":      "ada@example.com",
+					"Id":         "003ak000004nJ3FAAU",
+				},
+			}},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.RecordConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func TestGetRecordNotFound(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Conditional{
+		Setup: mockserver.ContentJSON(),
+		If:    mockcond.PathSuffix("/services/data/v59.0/composite/sobjects/Contact"),
+		Then:  mockserver.ResponseString(http.StatusOK, `[null]`),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL)
+	if err != nil {
+		t.Fatalf("failed to create connector: %v", err)
+	}
+
+	_, err = connector.GetRecordByID(context.Background(), common.GetRecordParams{
+		ObjectName: "Contact",
+		RecordId:   "003ak000004nJ3GAAU",
+		Fields:     connectors.Fields("Email"),
+	})
+	if !errors.Is(err, common.ErrRecordNotFound) {
+		t.Fatalf("expected Error: (%v), got: (%v)", common.ErrRecordNotFound, err)
+	}
+}
@@ -0,0 +1,171 @@
+package salesforce
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/amp-labs/connectors/internal/datautils"
+)
+
+// soqlOperators maps provider-neutral operators to SOQL comparison operators.
+var soqlOperators = map[common.SearchOperator]string{ // nolint:gochecknoglobals
+	common.SearchOperatorEquals:             "=",
+	common.SearchOperatorNotEquals:          "!=",
+	common.SearchOperatorGreaterThan:        ">",
+	common.SearchOperatorGreaterThanOrEqual: ">=",
+	common.SearchOperatorLessThan:           "<",
+	common.SearchOperatorLessThanOrEqual:    "<=",
+}
+
+// soqlExpression renders filter as SOQL condition expression.
+var soqlExpression = common.SearchExpression{ // nolint:gochecknoglobals
+	And:       "AND",
+	Or:        "OR",
+	Condition: makeSOQLCondition,
+}
+
+// SearchRecords implements connectors.SearchConnector by translating filter into SOQL WHERE clause.
+// Values are escaped and names are validated, therefore, unlike ReadParams.Filter,
+// filter is safe to build from user input.
+// PageSize is passed as a batch size hint, Salesforce accepts values between 200 and 2000.
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateIdentifiers(); err != nil {
+		return nil, err
+	}
+
+	url, err := c.buildSearchURL(params)
+	if err != nil {
+		return nil, err
+	}
+
+	var headers []common.Header
+	if params.PageSize != 0 {
+		headers = append(headers, common.Header{
+			Key:   "Sforce-Query-Options",
+			Value: fmt.Sprintf("batchSize=%v", params.PageSize),
+		})
+	}
+
+	rsp, err := c.Client.Get(ctx, url.String(), headers...)
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(
+		rsp,
+		getRecords,
+		getNextRecordsURL,
+		common.GetMarshaledData,
+		params.Fields,
+		common.WithPreservedFieldCase(params.PreserveFieldCase),
+	)
+}
+
+func (c *Connector) buildSearchURL(params common.SearchParams) (*urlbuilder.URL, error) {
+	if len(params.NextPage) != 0 {
+		return c.getDomainURL(params.NextPage.String())
+	}
+
+	soql := (&soqlBuilder{}).SelectFields(params.Fields.List()).From(params.ObjectName)
+
+	if !params.Filter.IsEmpty() {
+		condition, err := soqlExpression.Render(params.Filter)
+		if err != nil {
+			return nil, err
+		}
+
+		soql.Where(condition)
+	}
+
+	for _, sort := range params.Sort {
+		soql.OrderBy(sort.Field, sort.Descending)
+	}
+
+	url, err := c.getRestApiURL("query")
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("q", soql.String())
+
+	return url, nil
+}
+
+func makeSOQLCondition(condition common.SearchFilter) (string, error) {
+	switch condition.Operator {
+	case common.SearchOperatorIn:
+		values := condition.Values()
+		literals := make([]string, len(values))
+
+		for index, value := range values {
+			literal, err := soqlLiteral(value)
+			if err != nil {
+				return "", err
+			}
+
+			literals[index] = literal
+		}
+
+		return fmt.Sprintf("%v IN (%v)", condition.Field, strings.Join(literals, ",")), nil
+	case common.SearchOperatorContains:
+		text, ok := condition.Value.(string)
+		if !ok {
+			return "", fmt.Errorf("%w: %T for %v", common.ErrUnsupportedSearchValue, condition.Value, condition.Operator)
+		}
+
+		pattern := soqlLikeEscaper.Replace(soqlStringEscaper.Replace(text))
+
+		return fmt.Sprintf("%v LIKE '%%%v%%'", condition.Field, pattern), nil
+	}
+
+	operator, ok := soqlOperators[condition.Operator]
+	if !ok {
+		return "", common.UnsupportedSearchOperator(condition.Operator)
+	}
+
+	literal, err := soqlLiteral(condition.Value)
+	if err != nil {
+		return "", err
+	}
+
+	return fmt.Sprintf("%v %v %v", condition.Field, operator, literal), nil
+}
+
+var (
+	// https://developer.salesforce.com/docs/atlas.en-us.soql_sosl.meta/soql_sosl/sforce_api_calls_soql_select_quotedstringescapes.htm
+	soqlStringEscaper = strings.NewReplacer(`\`, `\\`, `'`, `\'`, "\n", `\n`, "\r", `\r`, "\t", `\t`) // nolint:gochecknoglobals
+	// Wildcards of LIKE operator are matched literally.
+	soqlLikeEscaper = strings.NewReplacer(`%`, `\%`, `_`, `\_`) // nolint:gochecknoglobals
+)
+
+// soqlLiteral formats value, strings are quoted while dates, numbers and booleans are not.
+// Other types are rejected, they have no SOQL literal and must not reach the query as raw text.
+func soqlLiteral(value any) (string, error) {
+	switch typed := value.(type) {
+	case nil:
+		return "null", nil
+	case string:
+		return "'" + soqlStringEscaper.Replace(typed) + "'", nil
+	case time.Time:
+		return datautils.Time.FormatRFC3339inUTC(typed), nil
+	case bool:
+		return strconv.FormatBool(typed), nil
+	case int, int8, int16, int32, int64, uint, uint8, uint16, uint32, uint64:
+		return fmt.Sprint(typed), nil
+	case float32:
+		return strconv.FormatFloat(float64(typed), 'f', -1, 32), nil
+	case float64:
+		return strconv.FormatFloat(typed, 'f', -1, 64), nil
+	default:
+		return "", fmt.Errorf("%w: %T", common.ErrUnsupportedSearchValue, value)
+	}
+}
@@ -0,0 +1,130 @@
+package salesforce
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestSearchRecords(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.Search{
+		{
+			Name: "Unsupported operator is rejected",
+			Input: common.SearchParams{
+				ObjectName: "Contact",
+				Fields:     connectors.Fields("Id"),
+				Filter:     common.SearchCondition("Email", "regex", ".*"),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedSearchOperator},
+		},
+		{
+			Name: "Field name cannot inject SOQL",
+			Input: common.SearchParams{
+				ObjectName: "Contact",
+				Fields:     connectors.Fields("Id"),
+				Filter:     common.SearchCondition("Email = 'x' OR Id", common.SearchOperatorNotEquals, nil),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrInvalidSearchIdentifier},
+		},
+		{
+			Name: "Selected field cannot inject SOQL",
+			Input: common.SearchParams{
+				ObjectName: "Contact",
+				Fields:     connectors.Fields("Id", "Name FROM User WHERE Id != null), Email"),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrInvalidSearchIdentifier},
+		},
+		{
+			Name: "Value without SOQL literal is rejected",
+			Input: common.SearchParams{
+				ObjectName: "Contact",
+				Fields:     connectors.Fields("Id"),
+				Filter: common.SearchCondition("Email", common.SearchOperatorEquals,
+					map[string]any{"x": "'' OR Id != null"}),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedSearchValue},
+		},
+		{
+			Name: "Contains requires text value",
+			Input: common.SearchParams{
+				ObjectName: "Contact",
+				Fields:     connectors.Fields("Id"),
+				Filter:     common.SearchCondition("Email", common.SearchOperatorContains, []byte("%")),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedSearchValue},
+		},
+		{
+			Name: "Filter is translated into escaped SOQL",
+			Input: common.SearchParams{
+				ObjectName: "Contact",
+				Fields:     connectors.Fields("Email"),
+				Filter: common.SearchAll(
+					common.SearchCondition("Email", common.SearchOperatorEquals, "o'hara@example.com"),
+					common.SearchAny(
+						common.SearchCondition("LastModifiedDate", common.SearchOperatorGreaterThanOrEqual,
+							time.Date(2024, 9, 19, 4, 30, 45, 0, time.UTC)),
+						common.SearchCondition("LeadSource", common.SearchOperatorIn, []string{"Web", "Phone"}),
+					),
+					common.SearchCondition("Title", common.SearchOperatorContains, "50%"),
+				),
+				Sort:     []common.SearchSort{{Field: "LastModifiedDate", Descending: true}},
+				PageSize: 500,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/services/data/v59.0/query"),
+					mockcond.QueryParam("q", "SELECT Email FROM Contact WHERE "+
+						`Email = 'o\'hara@example.com' AND `+
+						`(LastModifiedDate >= 2024-09-19T04:30:45Z OR LeadSource IN ('Web','Phone')) AND `+
+						`Title LIKE '%50\%%' `+
+						"ORDER BY LastModifiedDate DESC"),
+					mockcond.Header(http.Header{"Sforce-Query-Options": []string{"batchSize=500"}}),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"totalSize": 1,
+					"done": true,
+					"records": [{"attributes": {"type": "Contact"}, "Id": "003ak", "Email": "o'hara@example.com"}]
+				}`),
+			}.Server(),
+			Comparator: func(serverURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"email": "o'hara@example.com"},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.SearchConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
@@ -3,15 +3,18 @@ package salesforce
 import (
 	"fmt"
 	"strings"
+
+	"github.com/amp-labs/connectors/common/jsonquery"
 )
 
 // soqlBuilder builder of Salesforce Object Query Language.
 // It constructs query dynamically.
 type soqlBuilder struct {
-	fields string
-	from   string
-	where  []string
-	limit  string
+	fields  string
+	from    string
+	where   []string
+	orderBy []string
+	limit   string
 }
 
 func (s *soqlBuilder) SelectFields(fields []string) *soqlBuilder {
@@ -26,7 +29,20 @@ func (s *soqlBuilder) SelectFields(fields []string) *soqlBuilder {
 		}
 	}
 
-	s.fields = strings.Join(fields, ",")
+	selection := make([]string, len(fields))
+
+	for index, field := range fields {
+		selection[index] = field
+
+		// Fields of related objects are selected using dotted notation, ex: "Owner.Name".
+		if jsonquery.IsNestedPath(field) {
+			if dotted, err := jsonquery.DottedPath(field); err == nil {
+				selection[index] = dotted
+			}
+		}
+	}
+
+	s.fields = strings.Join(selection, ",")
 
 	return s
 }
@@ -47,6 +63,16 @@ func (s *soqlBuilder) Where(condition string) *soqlBuilder {
 	return s
 }
 
+func (s *soqlBuilder) OrderBy(field string, descending bool) *soqlBuilder {
+	if descending {
+		field += " DESC"
+	}
+
+	s.orderBy = append(s.orderBy, field)
+
+	return s
+}
+
 func (s *soqlBuilder) String() string {
 	query := fmt.Sprintf("SELECT %s FROM %s", s.fields, s.from)
 
@@ -54,6 +80,10 @@ func (s *soqlBuilder) String() string {
 		query += " WHERE " + strings.Join(s.where, " AND ")
 	}
 
+	if len(s.orderBy) != 0 {
+		query += " ORDER BY " + strings.Join(s.orderBy, ",")
+	}
+
 	if len(s.limit) != 0 {
 		query += " LIMIT " + s.limit
 	}
@@ -0,0 +1,269 @@
+package salesforce
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Every REST response reports the org's consumption of daily API requests.
+// Example: "Sforce-Limit-Info: api-usage=25/5000".
+// https://developer.salesforce.com/docs/atlas.en-us.api_rest.meta/api_rest/headers_api_usage.htm
+const (
+	headerLimitInfo   = "Sforce-Limit-Info"
+	limitInfoAPIUsage = "api-usage"
+)
+
+// blockedRefreshInterval is how often limits are refreshed while requests are rejected by the threshold.
+// Usage decreases only via limits endpoint, otherwise the connector would stay blocked after quota renewal.
+const blockedRefreshInterval = time.Minute
+
+var ErrInvalidUsageThreshold = errors.New("usage threshold must be a ratio within [0, 1], zero disables the check")
+
+// APIUsage is a snapshot of the org's quotas as known to the connector.
+type APIUsage struct {
+	// Used is the number of API requests consumed within the last 24 hours.
+	Used int
+	// Max is the daily allowance of API requests.
+	Max int
+	// UpdatedAt is the time of the last update from either response header or limits endpoint.
+	UpdatedAt time.Time
+	// Limits is the last response of the limits endpoint, it covers bulk and streaming quotas.
+	// Nil until Limits or periodic refresh was called.
+	Limits *LimitsResponse
+	// LimitsUpdatedAt is the time when Limits were fetched.
+	LimitsUpdatedAt time.Time
+}
+
+// Remaining returns number of API requests left for the day.
+func (u APIUsage) Remaining() int {
+	return u.Max - u.Used
+}
+
+// APIUsage returns the most recent knowledge about org's API consumption.
+// Usage is captured from the Sforce-Limit-Info header of every response.
+func (c *Connector) APIUsage() APIUsage {
+	return c.usage.snapshot()
+}
+
+// usageGauge holds API consumption for a connector and decides if further requests are allowed.
+type usageGauge struct {
+	mutex sync.RWMutex
+	usage APIUsage
+
+	// threshold is a ratio of daily requests, once reached further calls fail fast. Zero disables the check.
+	threshold float64
+	// refreshInterval defines how often limits endpoint is queried. Zero disables the refresh.
+	refreshInterval time.Duration
+	// refreshAttempt is the time of the last refresh, successful or not.
+	refreshAttempt time.Time
+	refreshing     bool
+}
+
+func newUsageGauge(threshold float64, refreshInterval time.Duration) *usageGauge {
+	return &usageGauge{
+		threshold:       threshold,
+		refreshInterval: refreshInterval,
+	}
+}
+
+func (g *usageGauge) snapshot() APIUsage {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	return g.usage
+}
+
+func (g *usageGauge) checkThreshold() error {
+	g.mutex.RLock()
+	defer g.mutex.RUnlock()
+
+	if g.threshold == 0 || g.usage.Max == 0 {
+		return nil
+	}
+
+	if float64(g.usage.Used) >= g.threshold*float64(g.usage.Max) {
+		return fmt.Errorf("%w: %v=%v/%v reached threshold of %v",
+			common.ErrLimitExceeded, limitInfoAPIUsage, g.usage.Used, g.usage.Max, g.threshold)
+	}
+
+	return nil
+}
+
+func (g *usageGauge) recordHeader(header http.Header) {
+	used, limit, ok := parseLimitInfo(header.Get(headerLimitInfo))
+	if !ok {
+		return
+	}
+
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.usage.Used = used
+	g.usage.Max = limit
+	g.usage.UpdatedAt = time.Now()
+}
+
+func (g *usageGauge) recordLimits(limits *LimitsResponse) {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	now := time.Now()
+	g.usage.Limits = limits
+	g.usage.LimitsUpdatedAt = now
+
+	// Response without daily quota doesn't change known usage.
+	if limits.DailyAPIRequests.Max == 0 {
+		return
+	}
+
+	g.usage.Used = limits.DailyAPIRequests.Max - limits.DailyAPIRequests.Remaining
+	g.usage.Max = limits.DailyAPIRequests.Max
+	g.usage.UpdatedAt = now
+}
+
+// startRefresh reports whether limits should be fetched now according to the refresh interval.
+func (g *usageGauge) startRefresh() bool {
+	return g.tryRefresh(g.refreshInterval)
+}
+
+// startBlockedRefresh reports whether limits should be fetched before rejecting the request.
+// Refresh happens regardless of configured interval, though not more often than blockedRefreshInterval.
+func (g *usageGauge) startBlockedRefresh() bool {
+	interval := blockedRefreshInterval
+	if g.refreshInterval != 0 {
+		interval = min(interval, g.refreshInterval)
+	}
+
+	return g.tryRefresh(interval)
+}
+
+// tryRefresh grants the refresh if the interval has passed since the last attempt.
+// Only one caller is granted the refresh at a time.
+func (g *usageGauge) tryRefresh(interval time.Duration) bool {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	if interval == 0 || g.refreshing {
+		return false
+	}
+
+	if time.Since(g.refreshAttempt) < interval {
+		return false
+	}
+
+	g.refreshing = true
+	g.refreshAttempt = time.Now()
+
+	return true
+}
+
+func (g *usageGauge) stopRefresh() {
+	g.mutex.Lock()
+	defer g.mutex.Unlock()
+
+	g.refreshing = false
+}
+
+// parseLimitInfo extracts usage from header value.
+// Value may list several usages: "api-usage=18/5000, per-app-api-usage=17/250(appName=sample-app)".
+func parseLimitInfo(value string) (used int, limit int, ok bool) {
+	for _, part := range strings.Split(value, ",") {
+		key, usage, found := strings.Cut(strings.TrimSpace(part), "=")
+		if !found || key != limitInfoAPIUsage {
+			continue
+		}
+
+		usedText, limitText, found := strings.Cut(usage, "/")
+		if !found {
+			return 0, 0, false
+		}
+
+		used, errUsed := strconv.Atoi(usedText)
+		limit, errLimit := strconv.Atoi(limitText)
+
+		if errUsed != nil || errLimit != nil {
+			return 0, 0, false
+		}
+
+		return used, limit, true
+	}
+
+	return 0, 0, false
+}
+
+// usageTrackingClient is a decorator of authenticated client.
+// Every response updates the gauge, while requests are rejected once threshold is reached.
+type usageTrackingClient struct {
+	common.AuthenticatedHTTPClient
+
+	gauge *usageGauge
+	// refresh fetches limits endpoint.
+	refresh func(ctx context.Context) error
+}
+
+func (c *usageTrackingClient) Do(req *http.Request) (*http.Response, error) {
+	// Calls to limits resource do not count against the quota and must always go through,
+	// otherwise gauge would never learn that quota was renewed.
+	if !isLimitsRequest(req) {
+		if c.gauge.startRefresh() {
+			c.refreshLimits(req.Context())
+		}
+
+		if err := c.checkThreshold(req.Context()); err != nil {
+			return nil, err
+		}
+	}
+
+	rsp, err := c.AuthenticatedHTTPClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+
+	c.gauge.recordHeader(rsp.Header)
+
+	return rsp, nil
+}
+
+// checkThreshold rejects the request if usage is over the threshold even after limits were refreshed.
+func (c *usageTrackingClient) checkThreshold(ctx context.Context) error {
+	if err := c.gauge.checkThreshold(); err == nil || !c.gauge.startBlockedRefresh() {
+		return err
+	}
+
+	// Quota may have been renewed since the usage was recorded.
+	c.refreshLimits(ctx)
+
+	return c.gauge.checkThreshold()
+}
+
+// refreshLimits must be called only after the refresh was granted by the gauge.
+func (c *usageTrackingClient) refreshLimits(ctx context.Context) {
+	// Stale limits are not a reason to reject the request.
+	if err := c.refresh(ctx); err != nil {
+		slog.Warn("unable to refresh Salesforce limits", "error", err)
+	}
+
+	c.gauge.stopRefresh()
+}
+
+func isLimitsRequest(req *http.Request) bool {
+	return strings.HasSuffix(req.URL.Path, restAPISuffix+"/limits")
+}
+
+// unwrapClient returns the client which was supplied to the connector.
+func unwrapClient(client common.AuthenticatedHTTPClient) common.AuthenticatedHTTPClient { // nolint:ireturn
+	if tracker, ok := client.(*usageTrackingClient); ok {
+		return tracker.AuthenticatedHTTPClient
+	}
+
+	return client
+}
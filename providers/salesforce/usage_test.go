@@ -0,0 +1,163 @@
+package salesforce
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestParseLimitInfo(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		input string
+		used  int
+		limit int
+		ok    bool
+	}{
+		{input: "api-usage=25/5000", used: 25, limit: 5000, ok: true},
+		{input: "per-app-api-usage=17/250(appName=sample-app), api-usage=18/5000", used: 18, limit: 5000, ok: true},
+		{input: "api-usage=abc/5000", ok: false},
+		{input: "", ok: false},
+	}
+
+	for _, tt := range tests {
+		used, limit, ok := parseLimitInfo(tt.input)
+		if used != tt.used || limit != tt.limit || ok != tt.ok {
+			t.Fatalf("%s: expected (%v, %v, %v), got (%v, %v, %v)",
+				tt.input, tt.used, tt.limit, tt.ok, used, limit, ok)
+		}
+	}
+}
+
+func TestAPIUsageThreshold(t *testing.T) {
+	t.Parallel()
+
+	server := mockserver.Fixed{
+		Setup: func(w http.ResponseWriter, r *http.Request) {
+			mockserver.ContentJSON()(w, r)
+			w.Header().Set("Sforce-Limit-Info", "api-usage=4600/5000")
+		},
+		Always: mockserver.ResponseString(http.StatusOK, `{"totalSize":0,"done":true,"records":[]}`),
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL, WithAPIUsageThreshold(0.9))
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	ctx := context.Background()
+	params := common.ReadParams{ObjectName: "Account", Fields: connectors.Fields("Id")}
+
+	if _, err = connector.Read(ctx, params); err != nil {
+		t.Fatalf("first request is expected to pass, got %v", err)
+	}
+
+	usage := connector.APIUsage()
+	if usage.Used != 4600 || usage.Max != 5000 || usage.Remaining() != 400 {
+		t.Fatalf("usage was not captured from header, got %v", usage)
+	}
+
+	_, err = connector.Read(ctx, params)
+	testutils.CheckErrors(t, "Quota threshold is enforced", []error{common.ErrLimitExceeded}, err)
+}
+
+func TestAPIUsageThresholdRecoversAfterRenewal(t *testing.T) {
+	t.Parallel()
+
+	responseLimits := testutils.DataFromFile(t, "limits.json")
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: []mockserver.Case{{
+			If:   mockcond.PathSuffix("/services/data/v59.0/limits"),
+			Then: mockserver.Response(http.StatusOK, responseLimits),
+		}, {
+			If: mockcond.PathSuffix("/services/data/v59.0/query"),
+			Then: func(w http.ResponseWriter, r *http.Request) {
+				w.Header().Set("Sforce-Limit-Info", "api-usage=4600/5000")
+				mockserver.ResponseString(http.StatusOK, `{"totalSize":0,"done":true,"records":[]}`)(w, r)
+			},
+		}},
+	}.Server()
+	defer server.Close()
+
+	// Periodic refresh is not enabled, blocked request still refreshes limits.
+	connector, err := constructTestConnector(server.URL, WithAPIUsageThreshold(0.9))
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	ctx := context.Background()
+	params := common.ReadParams{ObjectName: "Account", Fields: connectors.Fields("Id")}
+
+	if _, err = connector.Read(ctx, params); err != nil {
+		t.Fatalf("first request is expected to pass, got %v", err)
+	}
+
+	if _, err = connector.Read(ctx, params); err != nil {
+		t.Fatalf("request is expected to pass once limits report renewed quota, got %v", err)
+	}
+
+	if usage := connector.APIUsage(); usage.Limits == nil {
+		t.Fatalf("limits were not refreshed for blocked request, got %v", usage)
+	}
+}
+
+func TestUsageThresholdValidation(t *testing.T) {
+	t.Parallel()
+
+	for _, ratio := range []float64{-0.1, 1.5} {
+		_, err := constructTestConnector("https://example.com", WithAPIUsageThreshold(ratio))
+		testutils.CheckErrors(t, "Threshold out of range", []error{ErrInvalidUsageThreshold}, err)
+	}
+
+	if _, err := constructTestConnector("https://example.com", WithAPIUsageThreshold(0)); err != nil {
+		t.Fatalf("zero threshold disables the check, got %v", err)
+	}
+}
+
+func TestLimitsRefresh(t *testing.T) {
+	t.Parallel()
+
+	responseLimits := testutils.DataFromFile(t, "limits.json")
+
+	server := mockserver.Switch{
+		Setup: mockserver.ContentJSON(),
+		Cases: []mockserver.Case{{
+			If:   mockcond.PathSuffix("/services/data/v59.0/limits"),
+			Then: mockserver.Response(http.StatusOK, responseLimits),
+		}, {
+			If:   mockcond.PathSuffix("/services/data/v59.0/query"),
+			Then: mockserver.ResponseString(http.StatusOK, `{"totalSize":0,"done":true,"records":[]}`),
+		}},
+	}.Server()
+	defer server.Close()
+
+	connector, err := constructTestConnector(server.URL, WithLimitsRefreshInterval(time.Hour))
+	if err != nil {
+		t.Fatalf("error in test while constructing connector %v", err)
+	}
+
+	_, err = connector.Read(context.Background(), common.ReadParams{
+		ObjectName: "Account",
+		Fields:     connectors.Fields("Id"),
+	})
+	if err != nil {
+		t.Fatalf("read failed %v", err)
+	}
+
+	usage := connector.APIUsage()
+	if usage.Limits == nil || usage.Used != 10 || usage.Max != 5000 ||
+		usage.Limits.DailyBulkV2QueryJobs.Remaining != 9999 {
+		t.Fatalf("limits were not refreshed before request, got %v", usage)
+	}
+}
@@ -0,0 +1,19 @@
+package salesloft
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/salesloft/metadata"
+)
+
+// Capabilities is derived from the static schema. Since is passed as updated_at[gte] query parameter.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return metadata.Schemas.Capabilities(c.Module.ID, func(string) common.ReadCapabilities {
+		return common.ReadCapabilities{
+			Incremental: true,
+			Pagination:  common.PaginationPage,
+			MaxPageSize: DefaultPageSize,
+		}
+	}, common.OperationCreate, common.OperationUpdate, common.OperationDelete), nil
+}
@@ -0,0 +1,19 @@
+package smartlead
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+)
+
+// Capabilities describes supported objects. Smartlead returns all records at once without time filtering.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	return common.NewCapabilities().
+		WithRead(common.ReadCapabilities{
+			Pagination: common.PaginationNone,
+		}, supportedObjectsByRead[c.Module.ID].List()...).
+		WithOperation(common.OperationCreate, supportedObjectsByWrite.List()...).
+		// Only email accounts have update endpoint, see constructURLPathUpdate.
+		WithOperation(common.OperationUpdate, objectNameEmailAccount).
+		WithOperation(common.OperationDelete, supportedObjectsByDelete.List()...), nil
+}
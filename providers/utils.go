@@ -26,7 +26,8 @@ var (
 type CatalogOption func(params *catalogParams)
 
 type catalogParams struct {
-	catalog *CatalogWrapper
+	catalog  *CatalogWrapper
+	overlays []CatalogOverlay
 }
 
 // WithCatalog is an option that can be used to override the default catalog.
@@ -38,10 +39,13 @@ func WithCatalog(c *CatalogWrapper) CatalogOption {
 
 type CustomCatalog struct {
 	custom *CatalogWrapper
+	// err is a failure to apply overlays, it is reported on every read.
+	err error
 }
 
 // NewCustomCatalog allows to apply modifiers on the base catalog, to tweak its content.
 // Just like the default catalog it supports reading data, resolves variable substitutions.
+// Overlays are applied last, on top of the base or custom catalog.
 func NewCustomCatalog(opts ...CatalogOption) CustomCatalog {
 	params := &catalogParams{catalog: getCatalog()}
 
@@ -49,10 +53,20 @@ func NewCustomCatalog(opts ...CatalogOption) CustomCatalog {
 		opt(params)
 	}
 
-	return CustomCatalog{custom: params.catalog}
+	if params.catalog == nil || len(params.overlays) == 0 {
+		return CustomCatalog{custom: params.catalog}
+	}
+
+	custom, err := applyOverlays(params.catalog, params.overlays)
+
+	return CustomCatalog{custom: custom, err: err}
 }
 
 func (c CustomCatalog) catalog() (*CatalogWrapper, error) {
+	if c.err != nil {
+		return nil, c.err
+	}
+
 	if c.custom == nil {
 		// Null catalog was probably set via options.
 		// This is not allowed.
@@ -88,6 +102,10 @@ func (c CustomCatalog) ReadCatalog() (*CatalogWrapper, error) {
 		providerInfo.Name = provider
 	}
 
+	if err = applyDefaultRegions(catalogCopy.Catalog); err != nil {
+		return nil, err
+	}
+
 	return catalogCopy, nil
 }
 
@@ -111,6 +129,7 @@ func SetInfo(provider Provider, info ProviderInfo) {
 // ReadInfo reads the information from the catalog for specific provider. It also performs string substitution
 // on the values in the config that are surrounded by {{}}, if vars are provided.
 // The catalog variable will be applied such that `{{.VAR_NAME}}` string will be replaced with `VAR_VALUE`.
+// Providers with regional URLs get their default region unless region variable is among vars.
 func ReadInfo(provider Provider, vars ...catalogreplacer.CatalogVariable) (*ProviderInfo, error) {
 	return NewCustomCatalog().ReadInfo(provider, vars...)
 }
@@ -126,6 +145,11 @@ func (c CustomCatalog) ReadInfo(provider Provider, vars ...catalogreplacer.Catal
 		return nil, ErrProviderNotFound
 	}
 
+	vars, err = resolveRegion(provider, vars)
+	if err != nil {
+		return nil, err
+	}
+
 	// No substitution needed
 	if len(vars) == 0 {
 		return &pInfo, nil
@@ -0,0 +1,403 @@
+package providers
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"reflect"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/amp-labs/connectors/common/substitutions/catalogreplacer"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/go-playground/validator"
+)
+
+var ErrInvalidCatalog = errors.New("catalog is invalid")
+
+// placeholderRegex matches anything surrounded by double curly braces, ex: `{{.workspace}}`.
+var placeholderRegex = regexp.MustCompile(`{{(.*?)}}`) // nolint:gochecknoglobals
+
+// placeholderVariableRegex is the only allowed content of the placeholder.
+var placeholderVariableRegex = regexp.MustCompile(`^\s*\.([A-Za-z_][A-Za-z0-9_]*)\s*$`) // nolint:gochecknoglobals
+
+// IssueSeverity tells whether the issue must be fixed.
+type IssueSeverity string
+
+const (
+	// SeverityError is a misconfiguration which makes provider information unusable or misleading.
+	SeverityError IssueSeverity = "error"
+	// SeverityWarning is a suspicious configuration which may be intentional.
+	SeverityWarning IssueSeverity = "warning"
+)
+
+// ValidationIssue is a single problem found in the provider information.
+type ValidationIssue struct {
+	Provider Provider
+	// Field is a JSON path to the offending value, ex: "oauth2Opts.tokenURL".
+	Field    string
+	Severity IssueSeverity
+	Message  string
+}
+
+func (i ValidationIssue) String() string {
+	return fmt.Sprintf("%v: %v: %v: %v", i.Severity, i.Provider, i.Field, i.Message)
+}
+
+// ValidationIssues is a list of issues sorted by provider and field.
+type ValidationIssues []ValidationIssue
+
+// Errors returns issues which must be fixed.
+func (v ValidationIssues) Errors() ValidationIssues {
+	return v.filter(SeverityError)
+}
+
+// Warnings returns issues which may be intentional.
+func (v ValidationIssues) Warnings() ValidationIssues {
+	return v.filter(SeverityWarning)
+}
+
+// Err combines every issue of error severity. Returns nil if there is none.
+func (v ValidationIssues) Err() error {
+	issues := v.Errors()
+	if len(issues) == 0 {
+		return nil
+	}
+
+	errs := make([]error, len(issues))
+	for index, issue := range issues {
+		errs[index] = fmt.Errorf("%w: %v", ErrInvalidCatalog, issue)
+	}
+
+	return errors.Join(errs...)
+}
+
+func (v ValidationIssues) filter(severity IssueSeverity) ValidationIssues {
+	result := make(ValidationIssues, 0)
+
+	for _, issue := range v {
+		if issue.Severity == severity {
+			result = append(result, issue)
+		}
+	}
+
+	return result
+}
+
+// ConnectorImplementation describes which operations are implemented by the connector of a provider.
+type ConnectorImplementation struct {
+	Read  bool
+	Write bool
+}
+
+type ValidationOption func(params *validationParams)
+
+type validationParams struct {
+	implementations map[Provider]ConnectorImplementation
+	variables       datautils.StringSet
+}
+
+func (p *validationParams) knownVariables() []string {
+	names := p.variables.List()
+	sort.Strings(names)
+
+	return names
+}
+
+// WithImplementations enables comparison of Support flags against connectors which exist in code.
+// Providers absent from the map are treated as having no connector.
+func WithImplementations(implementations map[Provider]ConnectorImplementation) ValidationOption {
+	return func(params *validationParams) {
+		params.implementations = implementations
+	}
+}
+
+// WithCatalogVariables adds substitution variables which are allowed in addition to the
+// ones known by catalogreplacer package.
+func WithCatalogVariables(names ...string) ValidationOption {
+	return func(params *validationParams) {
+		params.variables.Add(names)
+	}
+}
+
+// Validate checks every provider in the catalog. See ValidateCatalog.
+func (c CustomCatalog) Validate(opts ...ValidationOption) (ValidationIssues, error) {
+	catalogInstance, err := c.catalog()
+	if err != nil {
+		return nil, err
+	}
+
+	return ValidateCatalog(catalogInstance.Catalog, opts...), nil
+}
+
+// ValidateCatalog checks that provider information is complete and consistent:
+//   - required fields are set;
+//   - options of the auth type are present and options of other auth types are absent;
+//   - placeholders in URLs refer to known catalog variables;
+//   - URLs are well-formed;
+//   - Support flags match implemented connectors, when WithImplementations is used.
+func ValidateCatalog(catalog CatalogType, opts ...ValidationOption) ValidationIssues {
+	params := &validationParams{
+		variables: datautils.NewStringSet(catalogreplacer.Variables()...),
+	}
+
+	for _, opt := range opts {
+		opt(params)
+	}
+
+	issues := make(ValidationIssues, 0)
+
+	for provider, info := range catalog {
+		validator := providerValidator{
+			provider: provider,
+			info:     info,
+			params:   params,
+		}
+
+		issues = append(issues, validator.validate()...)
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		if issues[i].Provider != issues[j].Provider {
+			return issues[i].Provider < issues[j].Provider
+		}
+
+		return issues[i].Field < issues[j].Field
+	})
+
+	return issues
+}
+
+type providerValidator struct {
+	provider Provider
+	info     ProviderInfo
+	params   *validationParams
+	issues   ValidationIssues
+}
+
+func (v *providerValidator) validate() ValidationIssues {
+	v.checkRequiredFields()
+	v.checkAuthOptions()
+	// Presence of base URL is checked by required tag.
+	v.checkURL("baseURL", v.info.BaseURL, false)
+	v.checkMedia()
+	v.checkSupport()
+
+	return v.issues
+}
+
+func (v *providerValidator) addError(field, format string, args ...any) {
+	v.add(SeverityError, field, format, args...)
+}
+
+func (v *providerValidator) addWarning(field, format string, args ...any) {
+	v.add(SeverityWarning, field, format, args...)
+}
+
+func (v *providerValidator) add(severity IssueSeverity, field, format string, args ...any) {
+	v.issues = append(v.issues, ValidationIssue{
+		Provider: v.provider,
+		Field:    field,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, args...),
+	})
+}
+
+func (v *providerValidator) checkRequiredFields() {
+	if err := newJSONValidator().Struct(v.info); err != nil {
+		var fieldErrors validator.ValidationErrors
+		if !errors.As(err, &fieldErrors) {
+			v.addError("", "%v", err)
+
+			return
+		}
+
+		for _, fieldErr := range fieldErrors {
+			field := strings.TrimPrefix(fieldErr.Namespace(), "ProviderInfo.")
+			v.addError(field, "failed %q validation", fieldErr.Tag())
+		}
+	}
+
+	if v.info.Name != v.provider {
+		v.addError("name", "name %q doesn't match catalog key", v.info.Name)
+	}
+}
+
+// newJSONValidator reports fields using their JSON names.
+func newJSONValidator() *validator.Validate {
+	validate := validator.New()
+	validate.RegisterTagNameFunc(func(field reflect.StructField) string {
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "" || name == "-" {
+			return field.Name
+		}
+
+		return name
+	})
+
+	return validate
+}
+
+func (v *providerValidator) checkAuthOptions() { // nolint:cyclop
+	switch v.info.AuthType {
+	case Oauth2, ApiKey, Basic, None:
+	default:
+		v.addError("authType", "unknown auth type %q", v.info.AuthType)
+	}
+
+	if v.info.AuthType == Oauth2 {
+		if v.info.Oauth2Opts == nil {
+			v.addError("oauth2Opts", "must be provided for oauth2 auth type")
+		} else {
+			v.checkOauth2Options(v.info.Oauth2Opts)
+		}
+	} else if v.info.Oauth2Opts != nil {
+		v.addError("oauth2Opts", "must be omitted for %v auth type", v.info.AuthType)
+	}
+
+	if v.info.AuthType == ApiKey {
+		if v.info.ApiKeyOpts == nil {
+			v.addError("apiKeyOpts", "must be provided for apiKey auth type")
+		} else {
+			v.checkApiKeyOptions(v.info.ApiKeyOpts)
+		}
+	} else if v.info.ApiKeyOpts != nil {
+		v.addError("apiKeyOpts", "must be omitted for %v auth type", v.info.AuthType)
+	}
+
+	if v.info.AuthType != Basic && v.info.BasicOpts != nil {
+		v.addError("basicOpts", "must be omitted for %v auth type", v.info.AuthType)
+	}
+}
+
+func (v *providerValidator) checkOauth2Options(opts *Oauth2Opts) {
+	switch opts.GrantType {
+	case AuthorizationCode, AuthorizationCodePKCE:
+		v.checkURL("oauth2Opts.authURL", opts.AuthURL, true)
+	case ClientCredentials, Password:
+		v.checkURL("oauth2Opts.authURL", opts.AuthURL, false)
+	default:
+		v.addError("oauth2Opts.grantType", "unknown grant type %q", opts.GrantType)
+	}
+
+	v.checkURL("oauth2Opts.tokenURL", opts.TokenURL, true)
+}
+
+func (v *providerValidator) checkApiKeyOptions(opts *ApiKeyOpts) {
+	switch opts.AttachmentType {
+	case Header:
+		if opts.Header == nil || opts.Header.Name == "" {
+			v.addError("apiKeyOpts.header.name", "must be provided for header attachment type")
+		}
+	case Query:
+		if opts.Query == nil || opts.Query.Name == "" {
+			v.addError("apiKeyOpts.query.name", "must be provided for query attachment type")
+		}
+	default:
+		v.addError("apiKeyOpts.attachmentType", "unknown attachment type %q", opts.AttachmentType)
+	}
+}
+
+func (v *providerValidator) checkMedia() {
+	if v.info.Media == nil {
+		return
+	}
+
+	if regular := v.info.Media.Regular; regular != nil {
+		v.checkURL("media.regular.iconURL", regular.IconURL, false)
+		v.checkURL("media.regular.logoURL", regular.LogoURL, false)
+	}
+
+	if darkMode := v.info.Media.DarkMode; darkMode != nil {
+		v.checkURL("media.darkMode.iconURL", darkMode.IconURL, false)
+		v.checkURL("media.darkMode.logoURL", darkMode.LogoURL, false)
+	}
+}
+
+// checkSupport compares Support flags with connector implementation.
+// Advertising an operation which is not implemented is an error,
+// while an implemented operation which is not advertised may be a connector in development.
+func (v *providerValidator) checkSupport() {
+	if v.params.implementations == nil {
+		return
+	}
+
+	implementation := v.params.implementations[v.provider]
+
+	v.compareSupport("support.read", "Read", v.info.Support.Read, implementation.Read)
+	v.compareSupport("support.write", "Write", v.info.Support.Write, implementation.Write)
+}
+
+func (v *providerValidator) compareSupport(field, method string, supported, implemented bool) {
+	if supported && !implemented {
+		v.addError(field, "is enabled, but connector doesn't implement %v", method)
+	}
+
+	if !supported && implemented {
+		v.addWarning(field, "is disabled, but connector implements %v", method)
+	}
+}
+
+// checkURL validates URL which may contain catalog variables.
+// Every placeholder must be a known variable, it is substituted with a sample value before parsing.
+func (v *providerValidator) checkURL(field, value string, required bool) {
+	if value == "" {
+		if required {
+			v.addError(field, "must be provided")
+		}
+
+		return
+	}
+
+	valid := true
+	resolved := placeholderRegex.ReplaceAllStringFunc(value, func(placeholder string) string {
+		content := placeholderRegex.FindStringSubmatch(placeholder)[1]
+
+		match := placeholderVariableRegex.FindStringSubmatch(content)
+		if match == nil {
+			v.addError(field, "malformed placeholder %q, expected format is {{.variable}}", placeholder)
+			valid = false
+
+			return placeholder
+		}
+
+		if !v.params.variables.Has(match[1]) {
+			v.addError(field, "unknown catalog variable %q, known variables are %v",
+				match[1], strings.Join(v.params.knownVariables(), ", "))
+			valid = false
+		}
+
+		if _, ok := ReadRegions(v.provider); match[1] == catalogreplacer.VariableRegion && !ok {
+			v.addError(field, "region variable is used, but regions are not declared, see SetRegions")
+		}
+
+		return "sample"
+	})
+
+	if !valid {
+		return
+	}
+
+	if strings.HasPrefix(value, "{{") {
+		// Variable holds the whole origin, ex: "{{.workspace}}/rest/v11".
+		resolved = "https://" + resolved
+	}
+
+	parsed, err := url.Parse(resolved)
+	if err != nil {
+		v.addError(field, "malformed URL %q: %v", value, err)
+
+		return
+	}
+
+	if parsed.Scheme != "https" && parsed.Scheme != "http" {
+		v.addError(field, "URL %q must use http or https scheme", value)
+
+		return
+	}
+
+	if parsed.Host == "" {
+		v.addError(field, "URL %q has no host", value)
+	}
+}
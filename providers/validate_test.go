@@ -0,0 +1,190 @@
+package providers
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestValidateCatalog(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	validOauth2 := ProviderInfo{
+		Name:     "test",
+		AuthType: Oauth2,
+		BaseURL:  "https://{{.workspace}}.test.com",
+		Oauth2Opts: &Oauth2Opts{
+			GrantType: AuthorizationCode,
+			AuthURL:   "https://test.com/oauth/authorize",
+			TokenURL:  "https://test.com/oauth/token",
+		},
+		Media: &Media{
+			Regular: &MediaTypeRegular{
+				IconURL: "https://test.com/icon.svg",
+			},
+		},
+		Support: Support{Read: true},
+	}
+
+	tests := []struct {
+		name     string
+		input    ProviderInfo
+		opts     []ValidationOption
+		expected ValidationIssues
+	}{
+		{
+			name:     "Valid provider has no issues",
+			input:    validOauth2,
+			expected: ValidationIssues{},
+		},
+		{
+			name: "Required fields are reported",
+			input: ProviderInfo{
+				Name:     "test",
+				AuthType: None,
+			},
+			expected: ValidationIssues{
+				{Provider: "test", Field: "baseURL", Severity: SeverityError, Message: `failed "required" validation`},
+			},
+		},
+		{
+			name: "Oauth2 options are required for oauth2",
+			input: ProviderInfo{
+				Name:     "test",
+				AuthType: Oauth2,
+				BaseURL:  "https://test.com",
+			},
+			expected: ValidationIssues{
+				{Provider: "test", Field: "oauth2Opts", Severity: SeverityError, Message: "must be provided for oauth2 auth type"},
+			},
+		},
+		{
+			name: "Oauth2 options are not allowed for other auth types",
+			input: ProviderInfo{
+				Name:     "test",
+				AuthType: ApiKey,
+				BaseURL:  "https://test.com",
+				ApiKeyOpts: &ApiKeyOpts{
+					AttachmentType: Header,
+					Header:         &ApiKeyOptsHeader{Name: "X-Api-Key"},
+				},
+				Oauth2Opts: validOauth2.Oauth2Opts,
+			},
+			expected: ValidationIssues{
+				{Provider: "test", Field: "oauth2Opts", Severity: SeverityError, Message: "must be omitted for apiKey auth type"},
+			},
+		},
+		{
+			name: "Unknown catalog variable is reported",
+			input: ProviderInfo{
+				Name:     "test",
+				AuthType: None,
+				BaseURL:  "https://{{.datacenter}}.test.com/{{workspace}}",
+			},
+			expected: ValidationIssues{
+				{
+					Provider: "test", Field: "baseURL", Severity: SeverityError,
+					Message: `unknown catalog variable "datacenter", known variables are region, server, workspace`,
+				},
+				{
+					Provider: "test", Field: "baseURL", Severity: SeverityError,
+					Message: `malformed placeholder "{{workspace}}", expected format is {{.variable}}`,
+				},
+			},
+		},
+		{
+			name: "Extra catalog variables can be allowed",
+			input: ProviderInfo{
+				Name:     "test",
+				AuthType: None,
+				BaseURL:  "https://{{.datacenter}}.test.com",
+			},
+			opts:     []ValidationOption{WithCatalogVariables("datacenter")},
+			expected: ValidationIssues{},
+		},
+		{
+			name: "Region variable requires declared regions",
+			input: ProviderInfo{
+				Name:     "test",
+				AuthType: None,
+				BaseURL:  "https://api.test.{{.region}}",
+			},
+			expected: ValidationIssues{
+				{
+					Provider: "test", Field: "baseURL", Severity: SeverityError,
+					Message: "region variable is used, but regions are not declared, see SetRegions",
+				},
+			},
+		},
+		{
+			name: "Variable can hold the whole origin",
+			input: ProviderInfo{
+				Name:     "test",
+				AuthType: None,
+				BaseURL:  "{{.workspace}}/rest/v1",
+			},
+			expected: ValidationIssues{},
+		},
+		{
+			name: "Media URLs must be well-formed",
+			input: ProviderInfo{
+				Name:     "test",
+				AuthType: None,
+				BaseURL:  "https://test.com",
+				Media: &Media{
+					DarkMode: &MediaTypeDarkMode{
+						LogoURL: "res.cloudinary.com/logo.svg",
+					},
+				},
+			},
+			expected: ValidationIssues{
+				{
+					Provider: "test", Field: "media.darkMode.logoURL", Severity: SeverityError,
+					Message: `URL "res.cloudinary.com/logo.svg" must use http or https scheme`,
+				},
+			},
+		},
+		{
+			name:  "Support flags are compared with implementations",
+			input: validOauth2,
+			opts: []ValidationOption{WithImplementations(map[Provider]ConnectorImplementation{
+				"test": {Read: false, Write: true},
+			})},
+			expected: ValidationIssues{
+				{
+					Provider: "test", Field: "support.read", Severity: SeverityError,
+					Message: "is enabled, but connector doesn't implement Read",
+				},
+				{
+					Provider: "test", Field: "support.write", Severity: SeverityWarning,
+					Message: "is disabled, but connector implements Write",
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			output := ValidateCatalog(CatalogType{"test": tt.input}, tt.opts...)
+			if !reflect.DeepEqual(output, tt.expected) {
+				t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, output)
+			}
+		})
+	}
+}
+
+func TestBuiltinCatalogIsValid(t *testing.T) {
+	t.Parallel()
+
+	issues, err := NewCustomCatalog().Validate()
+	if err != nil {
+		t.Fatalf("failed to validate catalog: %v", err)
+	}
+
+	if err = issues.Err(); err != nil {
+		t.Fatalf("builtin catalog has errors: %v", err)
+	}
+}
@@ -0,0 +1,170 @@
+package providers
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"time"
+)
+
+var ErrInvalidCatalogVersion = errors.New("catalog timestamp is not RFC3339")
+
+// Version returns the moment when the catalog was generated.
+// Catalogs are versioned by their Timestamp.
+func (c *CatalogWrapper) Version() (time.Time, error) {
+	version, err := time.Parse(time.RFC3339, c.Timestamp)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%w: %q", ErrInvalidCatalogVersion, c.Timestamp)
+	}
+
+	return version, nil
+}
+
+// CatalogDiff lists providers that were added, removed or modified between two catalog versions.
+type CatalogDiff struct {
+	FromVersion time.Time
+	ToVersion   time.Time
+	Added       []Provider
+	Removed     []Provider
+	// Changed holds modified fields of every provider present in both catalogs.
+	Changed map[Provider][]FieldChange
+}
+
+// FieldChange describes a single modified value of ProviderInfo.
+type FieldChange struct {
+	// Field is a dot separated JSON path, ex: "oauth2Opts.tokenURL".
+	Field string
+	// Old is nil when the field was added.
+	Old any
+	// New is nil when the field was removed.
+	New any
+}
+
+// IsEmpty returns true if catalogs have the same providers with identical information.
+func (d *CatalogDiff) IsEmpty() bool {
+	return len(d.Added) == 0 && len(d.Removed) == 0 && len(d.Changed) == 0
+}
+
+// DiffCatalogs compares two catalog releases. Both catalogs must have valid timestamps.
+// Values are compared using their JSON representation.
+func DiffCatalogs(from, to *CatalogWrapper) (*CatalogDiff, error) {
+	fromVersion, err := from.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	toVersion, err := to.Version()
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &CatalogDiff{
+		FromVersion: fromVersion,
+		ToVersion:   toVersion,
+		Added:       make([]Provider, 0),
+		Removed:     make([]Provider, 0),
+		Changed:     make(map[Provider][]FieldChange),
+	}
+
+	for provider, oldInfo := range from.Catalog {
+		newInfo, ok := to.Catalog[provider]
+		if !ok {
+			diff.Removed = append(diff.Removed, provider)
+
+			continue
+		}
+
+		changes, err := diffProviderInfo(oldInfo, newInfo)
+		if err != nil {
+			return nil, err
+		}
+
+		if len(changes) != 0 {
+			diff.Changed[provider] = changes
+		}
+	}
+
+	for provider := range to.Catalog {
+		if _, ok := from.Catalog[provider]; !ok {
+			diff.Added = append(diff.Added, provider)
+		}
+	}
+
+	sort.Strings(diff.Added)
+	sort.Strings(diff.Removed)
+
+	return diff, nil
+}
+
+func diffProviderInfo(oldInfo, newInfo ProviderInfo) ([]FieldChange, error) {
+	oldValues, err := toJSONMap(oldInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	newValues, err := toJSONMap(newInfo)
+	if err != nil {
+		return nil, err
+	}
+
+	changes := make([]FieldChange, 0)
+	diffValues("", oldValues, newValues, &changes)
+
+	sort.Slice(changes, func(i, j int) bool {
+		return changes[i].Field < changes[j].Field
+	})
+
+	return changes, nil
+}
+
+// diffValues recursively compares JSON objects. Other values, including arrays, are compared as a whole.
+func diffValues(path string, oldValue, newValue any, changes *[]FieldChange) {
+	oldObject, oldIsObject := oldValue.(map[string]any)
+	newObject, newIsObject := newValue.(map[string]any)
+
+	if !oldIsObject || !newIsObject {
+		if !reflect.DeepEqual(oldValue, newValue) {
+			*changes = append(*changes, FieldChange{
+				Field: path,
+				Old:   oldValue,
+				New:   newValue,
+			})
+		}
+
+		return
+	}
+
+	for key, value := range oldObject {
+		diffValues(joinPath(path, key), value, newObject[key], changes)
+	}
+
+	for key, value := range newObject {
+		if _, ok := oldObject[key]; !ok {
+			diffValues(joinPath(path, key), nil, value, changes)
+		}
+	}
+}
+
+func toJSONMap(info ProviderInfo) (map[string]any, error) {
+	data, err := json.Marshal(info)
+	if err != nil {
+		return nil, err
+	}
+
+	var result map[string]any
+	if err = json.Unmarshal(data, &result); err != nil {
+		return nil, err
+	}
+
+	return result, nil
+}
+
+func joinPath(path, key string) string {
+	if path == "" {
+		return key
+	}
+
+	return path + "." + key
+}
@@ -0,0 +1,94 @@
+package providers
+
+import (
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestDiffCatalogs(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	from := &CatalogWrapper{
+		Timestamp: "2024-11-01T00:00:00Z",
+		Catalog: CatalogType{
+			"stable":  {Name: "stable", AuthType: None, BaseURL: "https://stable.com"},
+			"retired": {Name: "retired", AuthType: None, BaseURL: "https://retired.com"},
+			"moved": {
+				Name:     "moved",
+				AuthType: Oauth2,
+				BaseURL:  "https://old.moved.com",
+				Oauth2Opts: &Oauth2Opts{
+					TokenURL: "https://old.moved.com/token",
+				},
+				Support: Support{Read: true},
+			},
+		},
+	}
+
+	to := &CatalogWrapper{
+		Timestamp: "2024-11-18T06:27:44Z",
+		Catalog: CatalogType{
+			"stable": {Name: "stable", AuthType: None, BaseURL: "https://stable.com"},
+			"fresh":  {Name: "fresh", AuthType: None, BaseURL: "https://fresh.com"},
+			"moved": {
+				Name:     "moved",
+				AuthType: Oauth2,
+				BaseURL:  "https://new.moved.com",
+				Oauth2Opts: &Oauth2Opts{
+					TokenURL: "https://new.moved.com/token",
+				},
+				Support:      Support{Read: true, Write: true},
+				ProviderOpts: ProviderOpts{"region": "eu"},
+			},
+		},
+	}
+
+	diff, err := DiffCatalogs(from, to)
+	if err != nil {
+		t.Fatalf("failed to diff catalogs: %v", err)
+	}
+
+	if diff.FromVersion.After(diff.ToVersion) {
+		t.Fatalf("versions are out of order: %v, %v", diff.FromVersion, diff.ToVersion)
+	}
+
+	if !reflect.DeepEqual(diff.Added, []Provider{"fresh"}) {
+		t.Fatalf("unexpected added providers: %v", diff.Added)
+	}
+
+	if !reflect.DeepEqual(diff.Removed, []Provider{"retired"}) {
+		t.Fatalf("unexpected removed providers: %v", diff.Removed)
+	}
+
+	expectedChanges := map[Provider][]FieldChange{
+		"moved": {
+			{Field: "baseURL", Old: "https://old.moved.com", New: "https://new.moved.com"},
+			{Field: "oauth2Opts.tokenURL", Old: "https://old.moved.com/token", New: "https://new.moved.com/token"},
+			{Field: "providerOpts", Old: nil, New: map[string]any{"region": "eu"}},
+			{Field: "support.write", Old: false, New: true},
+		},
+	}
+
+	if !reflect.DeepEqual(diff.Changed, expectedChanges) {
+		t.Fatalf("expected changes: (%v), got: (%v)", expectedChanges, diff.Changed)
+	}
+
+	same, err := DiffCatalogs(to, to)
+	if err != nil {
+		t.Fatalf("failed to diff catalogs: %v", err)
+	}
+
+	if !same.IsEmpty() {
+		t.Fatalf("catalog must not differ from itself: %v", same)
+	}
+}
+
+func TestDiffCatalogsRequiresVersion(t *testing.T) {
+	t.Parallel()
+
+	_, err := DiffCatalogs(&CatalogWrapper{Timestamp: "yesterday"}, getCatalog())
+	if !errors.Is(err, ErrInvalidCatalogVersion) {
+		t.Fatalf("expected Error: (%v), got: (%v)", ErrInvalidCatalogVersion, err)
+	}
+}
@@ -0,0 +1,39 @@
+package zendesksupport
+
+import (
+	"context"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/providers/zendesksupport/metadata"
+)
+
+// Capabilities lists objects of the static schema. Help Center objects are read only.
+// Ticketing objects are writable where the Zendesk OpenAPI file has matching endpoints, ex: tickets or users,
+// whereas audit logs, ticket metrics or satisfaction ratings are read only.
+// Objects of incremental export can be read incrementally, tickets and users can also read deleted records.
+func (c *Connector) Capabilities(ctx context.Context) (*common.Capabilities, error) {
+	readFeatures := func(objectName string) common.ReadCapabilities {
+		features := common.ReadCapabilities{
+			Pagination: common.PaginationNextURL,
+		}
+
+		if c.Module.ID == ModuleTicketing {
+			features.Incremental = incrementalExports.Has(objectName)
+			features.Deleted = deletedObjects.Has(objectName)
+		}
+
+		return features
+	}
+
+	capabilities := metadata.Schemas.Capabilities(c.Module.ID, readFeatures,
+		common.OperationCreate, common.OperationUpdate, common.OperationDelete)
+
+	// Objects accessible only via incremental export are not part of the schema.
+	for _, objectName := range supportedObjectsByRead[c.Module.ID].List() {
+		if _, ok := capabilities.Objects[objectName]; !ok {
+			capabilities.WithRead(readFeatures(objectName), objectName)
+		}
+	}
+
+	return capabilities, nil
+}
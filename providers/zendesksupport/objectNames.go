@@ -7,7 +7,18 @@ import (
 )
 
 // Supported object names can be found under schemas.json.
-var supportedObjectsByRead = metadata.Schemas.ObjectNames() //nolint:gochecknoglobals
+// Objects accessible only via incremental export are registered in addition.
+var supportedObjectsByRead = func() datautils.UniqueLists[common.ModuleID, string] { //nolint:gochecknoglobals
+	names := metadata.Schemas.ObjectNames()
+
+	for objectName, export := range incrementalExports {
+		if export.required {
+			names.Add(ModuleTicketing, objectName)
+		}
+	}
+
+	return names
+}()
 
 // ObjectNameToResponseField maps ObjectName to the response field name which contains that object.
 var ObjectNameToResponseField = common.ModuleObjectNameToFieldName{ //nolint:gochecknoglobals
@@ -2,21 +2,40 @@ package zendesksupport
 
 import (
 	"context"
+	"time"
 
 	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/common/urlbuilder"
 )
 
+// Read returns records of the object.
+//
+// When Since is set tickets, users, organizations and ticket events are read using incremental export.
+// Tickets and users are exported with cursor, which is returned as NextPage, while other objects paginate by URL.
+// Since must be kept unchanged across the pages of the same read.
+// Ticket events are available only via incremental export, reading from the beginning if Since is not set.
+//
+// Deleted reads soft deleted tickets or users, Since is not applied to them.
 func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common.ReadResult, error) {
 	if err := config.ValidateParams(true); err != nil {
 		return nil, err
 	}
 
+	if config.Deleted {
+		deletedObjectName, ok := deletedObjects[config.ObjectName]
+		if !ok || c.Module.ID != ModuleTicketing {
+			return nil, common.ErrOperationNotSupportedForObject
+		}
+
+		config.ObjectName = deletedObjectName
+		config.Since = time.Time{}
+	}
+
 	if !supportedObjectsByRead[c.Module.ID].Has(config.ObjectName) {
 		return nil, common.ErrOperationNotSupportedForObject
 	}
 
-	url, err := c.buildReadURL(config)
+	url, nextPage, err := c.buildReadRequest(config)
 	if err != nil {
 		return nil, err
 	}
@@ -31,12 +50,36 @@ func (c *Connector) Read(ctx context.Context, config common.ReadParams) (*common
 	return common.ParseResult(
 		rsp,
 		common.GetRecordsUnderJSONPath(responseFieldName),
-		getNextRecordsURL,
+		nextPage,
 		common.GetMarshaledData,
 		config.Fields,
+		common.WithPreservedFieldCase(config.PreserveFieldCase),
 	)
 }
 
+// buildReadRequest returns the URL together with the function locating the next page in the response.
+func (c *Connector) buildReadRequest(config common.ReadParams) (*urlbuilder.URL, common.NextPageFunc, error) {
+	if export, ok := c.lookupIncrementalExport(config); ok {
+		url, err := c.buildIncrementalReadURL(config, export)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if export.cursor {
+			return url, getNextIncrementalCursor, nil
+		}
+
+		return url, getNextIncrementalURL, nil
+	}
+
+	url, err := c.buildReadURL(config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return url, getNextRecordsURL, nil
+}
+
 func (c *Connector) buildReadURL(config common.ReadParams) (*urlbuilder.URL, error) {
 	if len(config.NextPage) != 0 {
 		// Next page
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"net/http"
 	"testing"
+	"time"
 
 	"github.com/amp-labs/connectors"
 	"github.com/amp-labs/connectors/common"
@@ -232,6 +233,190 @@ func TestReadHelpCenterModule(t *testing.T) { //nolint:funlen,gocognit,cyclop,ma
 	}
 }
 
+func TestReadIncrementalExport(t *testing.T) { //nolint:funlen,gocognit,cyclop
+	t.Parallel()
+
+	responseTickets := testutils.DataFromFile(t, "read-incremental-tickets.json")
+	responseOrganizations := testutils.DataFromFile(t, "read-incremental-organizations.json")
+	responseDeletedTickets := testutils.DataFromFile(t, "read-deleted-tickets.json")
+
+	since := time.Date(2024, 10, 1, 9, 0, 0, 0, time.UTC)
+
+	pageComparator := func(baseURL string, actual, expected *common.ReadResult) bool {
+		return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+			actual.NextPage.String() == expected.NextPage.String() &&
+			actual.Rows == expected.Rows &&
+			actual.Done == expected.Done
+	}
+
+	tests := []testroutines.Read{
+		{
+			Name: "Tickets since timestamp are exported with cursor",
+			Input: common.ReadParams{
+				ObjectName: "tickets",
+				Fields:     connectors.Fields("subject"),
+				Since:      since,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/api/v2/incremental/tickets/cursor"),
+					mockcond.QueryParam("start_time", "1727773200"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseTickets),
+			}.Server(),
+			Comparator: pageComparator,
+			Expected: &common.ReadResult{
+				Rows: 2,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"subject": "Printer is on fire"},
+				}, {
+					Fields: map[string]any{"subject": "Cannot log in"},
+				}},
+				NextPage: "MTcyNzc4MTIwMC4wfHw3fA==",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Next page of tickets is requested by cursor",
+			Input: common.ReadParams{
+				ObjectName: "tickets",
+				Fields:     connectors.Fields("subject"),
+				Since:      since,
+				NextPage:   "MTcyNzc4MTIwMC4wfHw3fA==",
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/api/v2/incremental/tickets/cursor"),
+					mockcond.QueryParam("cursor", "MTcyNzc4MTIwMC4wfHw3fA=="),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"tickets": [],
+					"after_cursor": "MTcyNzc4MTIwMC4wfHw5fA==",
+					"end_of_stream": true
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return actual.NextPage.String() == expected.NextPage.String() &&
+					actual.Rows == expected.Rows &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows:     0,
+				Data:     []common.ReadResultRow{},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Organizations export ends despite next page URL",
+			Input: common.ReadParams{
+				ObjectName: "organizations",
+				Fields:     connectors.Fields("name"),
+				Since:      since,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/api/v2/incremental/organizations"),
+					mockcond.QueryParam("start_time", "1727773200"),
+				},
+				Then: mockserver.Response(http.StatusOK, responseOrganizations),
+			}.Server(),
+			Comparator: pageComparator,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"name": "Ampersand"},
+				}},
+				NextPage: "",
+				Done:     true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Ticket events are exported from the beginning without since",
+			Input: common.ReadParams{
+				ObjectName: "ticket_events",
+				Fields:     connectors.Fields("ticket_id"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/api/v2/incremental/ticket_events"),
+					mockcond.QueryParam("start_time", "0"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"ticket_events": [{"id": 501, "ticket_id": 7, "event_type": "Create"}],
+					"next_page": "https://d3v-ampersand.zendesk.com/api/v2/incremental/ticket_events.json?start_time=1727773200",
+					"end_of_stream": false
+				}`),
+			}.Server(),
+			Comparator: pageComparator,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"ticket_id": float64(7)},
+				}},
+				NextPage: "https://d3v-ampersand.zendesk.com/api/v2/incremental/ticket_events.json?start_time=1727773200",
+				Done:     false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Deleted tickets are read from the deleted tickets endpoint",
+			Input: common.ReadParams{
+				ObjectName: "tickets",
+				Fields:     connectors.Fields("subject", "deleted_at"),
+				Since:      since,
+				Deleted:    true,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/api/v2/deleted_tickets"),
+				Then:  mockserver.Response(http.StatusOK, responseDeletedTickets),
+			}.Server(),
+			Comparator: pageComparator,
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{
+						"subject":    "Cannot log in",
+						"deleted_at": "2024-10-01T11:40:00Z",
+					},
+				}},
+				Done: true,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "Deleted records are not available for every object",
+			Input: common.ReadParams{
+				ObjectName: "triggers",
+				Fields:     connectors.Fields("id"),
+				Deleted:    true,
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrOperationNotSupportedForObject},
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.ReadConnector, error) {
+				return constructTestConnector(tt.Server.URL, ModuleTicketing)
+			})
+		})
+	}
+}
+
 func constructTestConnector(serverURL string, moduleID common.ModuleID) (*Connector, error) {
 	connector, err := NewConnector(
 		WithAuthenticatedClient(http.DefaultClient),
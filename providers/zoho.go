@@ -3,17 +3,32 @@ package providers
 const Zoho Provider = "zoho"
 
 func init() {
+	// Zoho has isolated datacenters, every one with its own domain.
+	// https://www.zoho.com/crm/developer/docs/api/v6/multi-dc.html
+	SetRegions(Zoho, RegionOpts{
+		Default: "us",
+		Hosts: map[string]string{
+			"us": "com",
+			"eu": "eu",
+			"in": "in",
+			"au": "com.au",
+			"jp": "jp",
+			"cn": "com.cn",
+			"sa": "sa",
+		},
+	})
+
 	// Zoho configuration
 	SetInfo(Zoho, ProviderInfo{
 		DisplayName: "Zoho",
 		AuthType:    Oauth2,
-		BaseURL:     "https://www.zohoapis.com",
+		BaseURL:     "https://www.zohoapis.{{.region}}",
 		Oauth2Opts: &Oauth2Opts{
 			GrantType: AuthorizationCode,
-			AuthURL:   "https://accounts.zoho.com/oauth/v2/auth",
+			AuthURL:   "https://accounts.zoho.{{.region}}/oauth/v2/auth",
 			// ref: https://www.zoho.com/analytics/api/v2/authentication/generating-code.html
 			AuthURLParams:             map[string]string{"access_type": "offline"},
-			TokenURL:                  "https://accounts.zoho.com/oauth/v2/token",
+			TokenURL:                  "https://accounts.zoho.{{.region}}/oauth/v2/token",
 			ExplicitScopesRequired:    true,
 			ExplicitWorkspaceRequired: false,
 			TokenMetadataFields: TokenMetadataFields{
@@ -7,7 +7,12 @@ import (
 	"github.com/amp-labs/connectors/providers"
 )
 
-const apiVersion = "crm/v6"
+const (
+	apiVersion = "crm/v6"
+
+	// apiDomainKey is a field of the token response holding API host of user's datacenter.
+	apiDomainKey = "api_domain"
+)
 
 type Connector struct {
 	BaseURL string
@@ -34,12 +39,16 @@ func NewConnector(opts ...Option) (conn *Connector, outErr error) {
 		},
 	}
 
-	providerInfo, err := providers.ReadInfo(conn.Provider())
+	providerInfo, err := providers.ReadInfo(conn.Provider(), &params.Region)
 	if err != nil {
 		return nil, err
 	}
 
-	conn.setBaseURL(providerInfo.BaseURL)
+	if len(params.apiDomain) != 0 {
+		conn.setBaseURL(params.apiDomain)
+	} else {
+		conn.setBaseURL(providerInfo.BaseURL)
+	}
 
 	return conn, nil
 }
@@ -0,0 +1,59 @@
+package zohocrm
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"golang.org/x/oauth2"
+)
+
+func TestNewConnectorBaseURL(t *testing.T) {
+	t.Parallel()
+
+	token := (&oauth2.Token{AccessToken: "token"}).WithExtra(map[string]any{
+		"api_domain": "https://www.zohoapis.com.au",
+	})
+
+	tests := []struct {
+		name     string
+		opts     []Option
+		expected string
+	}{
+		{
+			name:     "Default datacenter",
+			opts:     []Option{WithAuthenticatedClient(http.DefaultClient)},
+			expected: "https://www.zohoapis.com",
+		},
+		{
+			name:     "Region selects datacenter",
+			opts:     []Option{WithAuthenticatedClient(http.DefaultClient), WithRegion("eu")},
+			expected: "https://www.zohoapis.eu",
+		},
+		{
+			name: "API domain from token takes precedence",
+			opts: []Option{
+				WithClient(context.Background(), http.DefaultClient, &oauth2.Config{}, token),
+				WithRegion("eu"),
+			},
+			expected: "https://www.zohoapis.com.au",
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			conn, err := NewConnector(tt.opts...)
+			if err != nil {
+				t.Fatalf("%s: failed to create connector: %v", tt.name, err)
+			}
+
+			if conn.BaseURL != tt.expected {
+				t.Fatalf("%s: expected: (%v), got: (%v)", tt.name, tt.expected, conn.BaseURL)
+			}
+		})
+	}
+}
@@ -19,8 +19,9 @@ The normal standard response from ZohoCRM when Reading Records look like this:
 
 func responseHandler(resp *http.Response) (*http.Response, error) { //nolint:cyclop
 	// When there is no new record after the specified time `since`, ZohoCRM returns `304 Status Not Modified`.
+	// Search without matching records returns `204 No Content`.
 	// Then we wrap this response to 200 Status Okay with empty array data.
-	if resp.StatusCode == http.StatusNotModified {
+	if resp.StatusCode == http.StatusNotModified || resp.StatusCode == http.StatusNoContent {
 		// Build an empty Response Result (Mimicking ZohoResponse with empty data)
 		responseData := map[string]any{
 			"data": []any{},
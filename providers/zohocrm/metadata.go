@@ -15,6 +15,9 @@ const restMetadataEndpoint = "settings/fields"
 // apiKeyField is the key holding the metadata field name.
 const apiKeyField = "api_name"
 
+// dataTypeField is the key holding the type of the field.
+const dataTypeField = "data_type"
+
 type metadataFields struct {
 	Fields []map[string]any `json:"fields"`
 }
@@ -98,7 +101,8 @@ func parseMetadataResponse(resp *common.JSONHTTPResponse) (*common.ObjectMetadat
 	}
 
 	metadata := &common.ObjectMetadata{
-		FieldsMap: make(map[string]string),
+		FieldsMap:  make(map[string]string),
+		FieldTypes: make(common.FieldTypes),
 	}
 
 	// Ranging on the fields Slice, to construct the metadata fields.
@@ -106,8 +110,32 @@ func parseMetadataResponse(resp *common.JSONHTTPResponse) (*common.ObjectMetadat
 		apiField, ok := f[apiKeyField].(string)
 		if ok {
 			metadata.FieldsMap[apiField] = apiField
+
+			dataType, _ := f[dataTypeField].(string)
+			metadata.FieldTypes[apiField] = valueType(dataType)
 		}
 	}
 
 	return metadata, nil
 }
+
+// valueType converts data type of the field into common.ValueType.
+// Lookups and subforms are objects, they are left as is.
+func valueType(dataType string) common.ValueType {
+	switch dataType {
+	case "boolean":
+		return common.ValueTypeBoolean
+	case "integer", "bigint":
+		return common.ValueTypeInt
+	case "double", "currency", "decimal", "percent":
+		return common.ValueTypeFloat
+	case "date":
+		return common.ValueTypeDate
+	case "datetime":
+		return common.ValueTypeDateTime
+	case "text", "textarea", "email", "phone", "website", "picklist", "autonumber":
+		return common.ValueTypeString
+	default:
+		return common.ValueTypeOther
+	}
+}
@@ -14,11 +14,16 @@ type Option = func(params *parameters)
 
 type parameters struct {
 	paramsbuilder.Client
+	paramsbuilder.Region
+
+	// apiDomain is the datacenter specific API host of the user, ex: https://www.zohoapis.eu.
+	apiDomain string
 }
 
 func (p parameters) ValidateParams() error {
 	return errors.Join(
 		p.Client.ValidateParams(),
+		p.Region.ValidateParams(),
 	)
 }
 
@@ -27,6 +32,13 @@ func WithClient(ctx context.Context, client *http.Client,
 ) Option {
 	return func(params *parameters) {
 		params.WithOauthClient(ctx, client, config, token, opts...)
+
+		// Token response tells which datacenter holds user's data.
+		if token != nil {
+			if apiDomain, ok := token.Extra(apiDomainKey).(string); ok {
+				params.apiDomain = apiDomain
+			}
+		}
 	}
 }
 
@@ -35,3 +47,19 @@ func WithAuthenticatedClient(client common.AuthenticatedHTTPClient) Option {
 		params.WithAuthenticatedClient(client)
 	}
 }
+
+// WithRegion selects Zoho datacenter, ex: "eu", "in", "au". Default is "us".
+// See providers.ReadRegions for the complete list.
+func WithRegion(region string) Option {
+	return func(params *parameters) {
+		params.WithRegion(region)
+	}
+}
+
+// WithAPIDomain sets API host returned as "api_domain" in the token response.
+// It takes precedence over the region.
+func WithAPIDomain(apiDomain string) Option {
+	return func(params *parameters) {
+		params.apiDomain = apiDomain
+	}
+}
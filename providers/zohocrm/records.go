@@ -0,0 +1,59 @@
+package zohocrm
+
+import (
+	"context"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/naming"
+)
+
+// idsPerRequest is the number of record IDs Zoho accepts by a single request.
+const idsPerRequest = 100
+
+// GetRecordByID returns a record of the object with the given ID.
+func (c *Connector) GetRecordByID(ctx context.Context, params common.GetRecordParams) (*common.ReadResultRow, error) {
+	return common.GetRecordViaBatch(ctx, params, c.GetRecords)
+}
+
+// GetRecords returns records of the object listed by IDs.
+// ref: https://www.zoho.com/crm/developer/docs/api/v6/get-records.html
+func (c *Connector) GetRecords(ctx context.Context, params common.GetRecordsParams) ([]common.ReadResultRow, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	// Object names in ZohoCRM API are case sensitive.
+	obj := naming.CapitalizeFirstLetterEveryWord(params.ObjectName)
+	rows := make([]common.ReadResultRow, 0, len(params.RecordIds))
+
+	for _, batch := range params.Batches(idsPerRequest) {
+		url, err := c.getAPIURL(obj)
+		if err != nil {
+			return nil, err
+		}
+
+		url.WithQueryParam("fields", strings.Join(params.Fields.List(), ","))
+		url.WithQueryParam("ids", strings.Join(batch, ","))
+
+		res, err := c.Client.Get(ctx, url.String())
+		if err != nil {
+			return nil, err
+		}
+
+		result, err := common.ParseResult(res,
+			common.GetRecordsUnderJSONPath("data"),
+			getNextRecordsURL(url),
+			common.GetMarshaledData,
+			params.Fields,
+			common.WithPreservedFieldCase(params.PreserveFieldCase),
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		rows = append(rows, result.Data...)
+	}
+
+	return rows, nil
+}
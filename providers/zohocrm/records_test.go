@@ -0,0 +1,71 @@
+package zohocrm
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestGetRecords(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.GetRecords{
+		{
+			Name: "Records are listed by IDs",
+			Input: common.GetRecordsParams{
+				ObjectName: "deals",
+				RecordIds:  []string{"5725767000000524157", "5725767000000524158"},
+				Fields:     connectors.Fields("Deal_Name"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/crm/v6/Deals"),
+					mockcond.QueryParam("ids", "5725767000000524157,5725767000000524158"),
+					mockcond.QueryParam("fields", "Deal_Name"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"data": [{"id": "5725767000000524157", "Deal_Name": "Renewal"}],
+					"info": {"per_page": 200, "count": 1, "page": 1, "more_records": false}
+				}`),
+			}.Server(),
+			Expected: []common.ReadResultRow{{
+				Fields: map[string]any{"deal_name": "Renewal"},
+				Raw:    map[string]any{"id": "5725767000000524157", "Deal_Name": "Renewal"},
+			}},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "None of the records exist",
+			Input: common.GetRecordsParams{
+				ObjectName: "deals",
+				RecordIds:  []string{"5725767000000524159"},
+				Fields:     connectors.Fields("Deal_Name"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/crm/v6/Deals"),
+				Then:  mockserver.Response(http.StatusNoContent),
+			}.Server(),
+			Expected:     []common.ReadResultRow{},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.RecordConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
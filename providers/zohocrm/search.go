@@ -0,0 +1,197 @@
+package zohocrm
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/common/jsonquery"
+	"github.com/amp-labs/connectors/common/naming"
+	"github.com/amp-labs/connectors/common/urlbuilder"
+	"github.com/spyzhov/ajson"
+)
+
+// searchMaxPageSize is the largest number of records returned per page by the search endpoint.
+const searchMaxPageSize = 200
+
+// criteriaOperators maps provider-neutral operators to Zoho criteria comparators.
+var criteriaOperators = map[common.SearchOperator]string{ // nolint:gochecknoglobals
+	common.SearchOperatorEquals:             "equals",
+	common.SearchOperatorNotEquals:          "not_equal",
+	common.SearchOperatorGreaterThan:        "greater_than",
+	common.SearchOperatorGreaterThanOrEqual: "greater_equal",
+	common.SearchOperatorLessThan:           "less_than",
+	common.SearchOperatorLessThanOrEqual:    "less_equal",
+	common.SearchOperatorIn:                 "in",
+}
+
+// criteriaEscaper escapes characters reserved by the criteria syntax.
+var criteriaEscaper = strings.NewReplacer( // nolint:gochecknoglobals
+	`\`, `\\`,
+	"(", `\(`,
+	")", `\)`,
+	",", `\,`,
+)
+
+// SearchRecords implements connectors.SearchConnector using the criteria of the search endpoint.
+// Zoho returns search results in the default order, sorting is not supported.
+// Values are escaped and names are validated, so the filter is safe to build from user input.
+// ref: https://www.zoho.com/crm/developer/docs/api/v6/search-records.html
+func (c *Connector) SearchRecords(ctx context.Context, params common.SearchParams) (*common.ReadResult, error) {
+	if err := params.ValidateParams(); err != nil {
+		return nil, err
+	}
+
+	if err := params.ValidateIdentifiers(); err != nil {
+		return nil, err
+	}
+
+	if len(params.Sort) != 0 {
+		return nil, fmt.Errorf("%w: search results cannot be sorted", common.ErrUnsupportedSearchFilter)
+	}
+
+	// Search endpoint requires criteria, records matching empty filter are listed instead.
+	if params.Filter.IsEmpty() {
+		return c.Read(ctx, common.ReadParams{
+			ObjectName: params.ObjectName,
+			Fields:     params.Fields,
+			NextPage:   params.NextPage,
+
+			PreserveFieldCase: params.PreserveFieldCase,
+		})
+	}
+
+	url, err := c.buildSearchURL(params)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := c.Client.Get(ctx, url.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return common.ParseResult(res,
+		common.GetRecordsUnderJSONPath("data"),
+		getNextSearchPageURL(url),
+		common.GetMarshaledData,
+		params.Fields,
+		common.WithPreservedFieldCase(params.PreserveFieldCase),
+	)
+}
+
+func (c *Connector) buildSearchURL(params common.SearchParams) (*urlbuilder.URL, error) {
+	if len(params.NextPage) != 0 {
+		return urlbuilder.New(params.NextPage.String())
+	}
+
+	// Object names in ZohoCRM API are case sensitive.
+	obj := naming.CapitalizeFirstLetterEveryWord(params.ObjectName)
+
+	url, err := c.getAPIURL(obj + "/search")
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("fields", strings.Join(params.Fields.List(), ","))
+
+	criteria, err := makeCriteria(params.Filter)
+	if err != nil {
+		return nil, err
+	}
+
+	url.WithQueryParam("criteria", criteria)
+
+	pageSize := params.PageSize
+	if pageSize == 0 || pageSize > searchMaxPageSize {
+		pageSize = searchMaxPageSize
+	}
+
+	url.WithQueryParam("per_page", strconv.Itoa(pageSize))
+
+	return url, nil
+}
+
+// makeCriteria renders filter in Zoho syntax, where every condition and compound is enclosed in parentheses.
+// Example: ((Last_Name:equals:Burns)and(First_Name:starts_with:B)).
+func makeCriteria(filter common.SearchFilter) (string, error) {
+	if !filter.IsCompound() {
+		return makeCriteriaCondition(filter)
+	}
+
+	parts := make([]string, 0, len(filter.Filters))
+
+	for _, nested := range filter.Filters {
+		part, err := makeCriteria(nested)
+		if err != nil {
+			return "", err
+		}
+
+		parts = append(parts, part)
+	}
+
+	if len(parts) == 1 {
+		return parts[0], nil
+	}
+
+	return "(" + strings.Join(parts, string(filter.Logic)) + ")", nil
+}
+
+func makeCriteriaCondition(condition common.SearchFilter) (string, error) {
+	operator, ok := criteriaOperators[condition.Operator]
+	if !ok {
+		return "", common.UnsupportedSearchOperator(condition.Operator)
+	}
+
+	values := []any{condition.Value}
+	if condition.Operator == common.SearchOperatorIn {
+		values = condition.Values()
+	}
+
+	literals := make([]string, len(values))
+	for index, value := range values {
+		literals[index] = criteriaLiteral(value)
+	}
+
+	return fmt.Sprintf("(%v:%v:%v)", condition.Field, operator, strings.Join(literals, ",")), nil
+}
+
+// criteriaLiteral formats value, timestamps must include the offset.
+func criteriaLiteral(value any) string {
+	switch typed := value.(type) {
+	case nil:
+		return "null"
+	case time.Time:
+		return typed.Format("2006-01-02T15:04:05-07:00")
+	default:
+		return criteriaEscaper.Replace(fmt.Sprint(typed))
+	}
+}
+
+// getNextSearchPageURL advances page number, search endpoint doesn't return page tokens.
+func getNextSearchPageURL(url *urlbuilder.URL) common.NextPageFunc {
+	return func(node *ajson.Node) (string, error) {
+		info := jsonquery.New(node, "info")
+
+		hasMoreRecords, err := info.BoolWithDefault("more_records", false)
+		if err != nil {
+			return "", err
+		}
+
+		if !hasMoreRecords {
+			return "", nil
+		}
+
+		page, err := info.Integer("page", false)
+		if err != nil {
+			return "", err
+		}
+
+		url.WithQueryParam("page", strconv.FormatInt(*page+1, 10))
+
+		return url.String(), nil
+	}
+}
@@ -0,0 +1,124 @@
+package zohocrm
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/mockutils"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockcond"
+	"github.com/amp-labs/connectors/test/utils/mockutils/mockserver"
+	"github.com/amp-labs/connectors/test/utils/testroutines"
+)
+
+func TestSearchRecords(t *testing.T) { // nolint:funlen
+	t.Parallel()
+
+	tests := []testroutines.Search{
+		{
+			Name: "Search results cannot be sorted",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("Email"),
+				Filter:     common.SearchCondition("Email", common.SearchOperatorEquals, "ada@example.com"),
+				Sort:       []common.SearchSort{{Field: "Last_Name"}},
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedSearchFilter},
+		},
+		{
+			Name: "Contains operator is not supported by criteria",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("Email"),
+				Filter:     common.SearchCondition("Email", common.SearchOperatorContains, "example"),
+			},
+			Server:       mockserver.Dummy(),
+			ExpectedErrs: []error{common.ErrUnsupportedSearchOperator},
+		},
+		{
+			Name: "Filter is translated into escaped criteria",
+			Input: common.SearchParams{
+				ObjectName: "contacts",
+				Fields:     connectors.Fields("Email"),
+				Filter: common.SearchAll(
+					common.SearchCondition("Last_Name", common.SearchOperatorEquals, "Burns, Jr. (II)"),
+					common.SearchAny(
+						common.SearchCondition("Lead_Source", common.SearchOperatorIn, []string{"Web", "Phone"}),
+						common.SearchCondition("Modified_Time", common.SearchOperatorGreaterThanOrEqual,
+							time.Date(2024, 9, 19, 4, 30, 45, 0, time.UTC)),
+					),
+				),
+				PageSize: 50,
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If: mockcond.And{
+					mockcond.PathSuffix("/crm/v6/Contacts/search"),
+					mockcond.QueryParam("criteria", `((Last_Name:equals:Burns\, Jr. \(II\))and`+
+						`((Lead_Source:in:Web,Phone)or(Modified_Time:greater_equal:2024-09-19T04:30:45+00:00)))`),
+					mockcond.QueryParam("per_page", "50"),
+				},
+				Then: mockserver.ResponseString(http.StatusOK, `{
+					"data": [{"id": "5725767000000524157", "Email": "burns@example.com"}],
+					"info": {"per_page": 50, "count": 1, "page": 1, "more_records": true}
+				}`),
+			}.Server(),
+			Comparator: func(baseURL string, actual, expected *common.ReadResult) bool {
+				return mockutils.ReadResultComparator.SubsetFields(actual, expected) &&
+					actual.Done == expected.Done
+			},
+			Expected: &common.ReadResult{
+				Rows: 1,
+				Data: []common.ReadResultRow{{
+					Fields: map[string]any{"email": "burns@example.com"},
+				}},
+				Done: false,
+			},
+			ExpectedErrs: nil,
+		},
+		{
+			Name: "No matching records",
+			Input: common.SearchParams{
+				ObjectName: "leads",
+				Fields:     connectors.Fields("Email"),
+				Filter:     common.SearchCondition("Email", common.SearchOperatorEquals, "nobody@example.com"),
+			},
+			Server: mockserver.Conditional{
+				Setup: mockserver.ContentJSON(),
+				If:    mockcond.PathSuffix("/crm/v6/Leads/search"),
+				Then:  mockserver.Response(http.StatusNoContent),
+			}.Server(),
+			Expected:     &common.ReadResult{Rows: 0, Data: []common.ReadResultRow{}, Done: true},
+			ExpectedErrs: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		// nolint:varnamelen
+		tt := tt // rebind, omit loop side effects for parallel goroutine
+		t.Run(tt.Name, func(t *testing.T) {
+			t.Parallel()
+
+			tt.Run(t, func() (connectors.SearchConnector, error) {
+				return constructTestConnector(tt.Server.URL)
+			})
+		})
+	}
+}
+
+func constructTestConnector(serverURL string) (*Connector, error) {
+	connector, err := NewConnector(
+		WithAuthenticatedClient(http.DefaultClient),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// for testing we want to redirect calls to our mock server
+	connector.setBaseURL(serverURL)
+
+	return connector, nil
+}
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/mock"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/providers/apollo"
+	"github.com/amp-labs/connectors/providers/atlassian"
+	"github.com/amp-labs/connectors/providers/attio"
+	"github.com/amp-labs/connectors/providers/closecrm"
+	"github.com/amp-labs/connectors/providers/customerapp"
+	"github.com/amp-labs/connectors/providers/docusign"
+	"github.com/amp-labs/connectors/providers/dynamicscrm"
+	"github.com/amp-labs/connectors/providers/gong"
+	"github.com/amp-labs/connectors/providers/hubspot"
+	"github.com/amp-labs/connectors/providers/instantly"
+	"github.com/amp-labs/connectors/providers/intercom"
+	"github.com/amp-labs/connectors/providers/keap"
+	"github.com/amp-labs/connectors/providers/klaviyo"
+	"github.com/amp-labs/connectors/providers/marketo"
+	"github.com/amp-labs/connectors/providers/outreach"
+	"github.com/amp-labs/connectors/providers/pipedrive"
+	"github.com/amp-labs/connectors/providers/pipeliner"
+	"github.com/amp-labs/connectors/providers/salesforce"
+	"github.com/amp-labs/connectors/providers/salesloft"
+	"github.com/amp-labs/connectors/providers/smartlead"
+	"github.com/amp-labs/connectors/providers/zendesksupport"
+	"github.com/amp-labs/connectors/providers/zohocrm"
+)
+
+// Validates provider catalog. Exits with non-zero code when errors are found.
+//
+// Usage:
+//
+//	go run ./scripts/catalog/validate
+//	go run ./scripts/catalog/validate -warnings
+var (
+	withWarnings        bool // nolint:gochecknoglobals
+	skipImplementations bool // nolint:gochecknoglobals
+)
+
+// connectorTypes lists deep connectors. Values are typed nil pointers,
+// they are only used to check which interfaces are implemented.
+// A new deep connector must be added here, main_test.go fails otherwise.
+var connectorTypes = map[providers.Provider]connectors.Connector{ // nolint:gochecknoglobals
+	providers.Apollo:              (*apollo.Connector)(nil),
+	providers.Atlassian:           (*atlassian.Connector)(nil),
+	providers.Attio:               (*attio.Connector)(nil),
+	providers.Close:               (*closecrm.Connector)(nil),
+	providers.CustomerJourneysApp: (*customerapp.Connector)(nil),
+	providers.Docusign:            (*docusign.Connector)(nil),
+	providers.DynamicsCRM:         (*dynamicscrm.Connector)(nil),
+	providers.Gong:                (*gong.Connector)(nil),
+	providers.Hubspot:             (*hubspot.Connector)(nil),
+	providers.Instantly:           (*instantly.Connector)(nil),
+	providers.Intercom:            (*intercom.Connector)(nil),
+	providers.Keap:                (*keap.Connector)(nil),
+	providers.Klaviyo:             (*klaviyo.Connector)(nil),
+	providers.Marketo:             (*marketo.Connector)(nil),
+	providers.Mock:                (*mock.Connector)(nil),
+	providers.Outreach:            (*outreach.Connector)(nil),
+	providers.Pipedrive:           (*pipedrive.Connector)(nil),
+	providers.Pipeliner:           (*pipeliner.Connector)(nil),
+	providers.Salesforce:          (*salesforce.Connector)(nil),
+	providers.Salesloft:           (*salesloft.Connector)(nil),
+	providers.Smartlead:           (*smartlead.Connector)(nil),
+	providers.ZendeskSupport:      (*zendesksupport.Connector)(nil),
+	providers.Zoho:                (*zohocrm.Connector)(nil),
+}
+
+func init() {
+	flag.BoolVar(&withWarnings, "warnings", false,
+		"print issues which may be intentional")
+	flag.BoolVar(&skipImplementations, "skipImplementations", false,
+		"do not compare support flags against implemented connectors")
+}
+
+func main() {
+	flag.Parse()
+
+	var opts []providers.ValidationOption
+	if !skipImplementations {
+		opts = append(opts, providers.WithImplementations(getImplementations()))
+	}
+
+	issues, err := providers.NewCustomCatalog().Validate(opts...)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	errs := issues.Errors()
+	for _, issue := range errs {
+		fmt.Println(issue) // nolint:forbidigo
+	}
+
+	if withWarnings {
+		for _, issue := range issues.Warnings() {
+			fmt.Println(issue) // nolint:forbidigo
+		}
+	}
+
+	if len(errs) != 0 {
+		log.Printf("Catalog has %d errors\n", len(errs))
+		os.Exit(1)
+	}
+
+	log.Println("Catalog is valid")
+}
+
+func getImplementations() map[providers.Provider]providers.ConnectorImplementation {
+	implementations := make(map[providers.Provider]providers.ConnectorImplementation)
+
+	for provider, conn := range connectorTypes {
+		_, read := conn.(connectors.ReadConnector)
+		_, write := conn.(connectors.WriteConnector)
+
+		implementations[provider] = providers.ConnectorImplementation{
+			Read:  read,
+			Write: write,
+		}
+	}
+
+	return implementations
+}
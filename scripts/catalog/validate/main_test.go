@@ -0,0 +1,34 @@
+package main
+
+import (
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// TestConnectorTypesAreComplete ensures every deep connector package is listed in connectorTypes.
+func TestConnectorTypesAreComplete(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob(filepath.Join("..", "..", "..", "providers", "*", "connector.go"))
+	if err != nil {
+		t.Fatalf("failed to list connector packages: %v", err)
+	}
+
+	if len(files) == 0 {
+		t.Fatal("no connector packages found")
+	}
+
+	listed := make(map[string]bool)
+
+	for _, conn := range connectorTypes {
+		listed[filepath.Base(reflect.TypeOf(conn).Elem().PkgPath())] = true
+	}
+
+	for _, file := range files {
+		pkg := filepath.Base(filepath.Dir(file))
+		if !listed[pkg] {
+			t.Errorf("connector package %q is missing from connectorTypes", pkg)
+		}
+	}
+}
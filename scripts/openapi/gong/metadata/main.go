@@ -84,6 +84,8 @@ func main() {
 			schemas.Add("", object.ObjectName, object.DisplayName, field, object.URLPath, object.ResponseKey, nil)
 		}
 
+		schemas.AddFieldTypes("", object.ObjectName, object.FieldTypes)
+
 		for _, queryParam := range object.QueryParams {
 			registry.Add(queryParam, object.ObjectName)
 		}
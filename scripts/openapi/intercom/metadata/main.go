@@ -89,6 +89,11 @@ func main() {
 		}
 	}
 
+	writeObjects, err := explorer.WriteObjects(api3.NewDenyPathStrategy(ignoreEndpoints), nil)
+	goutils.MustBeNil(err)
+
+	scrapper.AddWriteOperations(schemas, "", writeObjects)
+
 	goutils.MustBeNil(metadata.FileManager.SaveSchemas(schemas))
 	goutils.MustBeNil(metadata.FileManager.SaveQueryParamStats(scrapper.CalculateQueryParamStats(registry)))
 
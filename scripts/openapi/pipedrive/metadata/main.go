@@ -3,7 +3,9 @@ package main
 import (
 	"log"
 	"log/slog"
+	"strings"
 
+	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/internal/datautils"
 	"github.com/amp-labs/connectors/internal/staticschema"
 	"github.com/amp-labs/connectors/providers/pipedrive/metadata"
@@ -61,6 +63,9 @@ var displayName = map[string]string{ // nolint:gochecknoglobals
 	"webhooks":                  "Webhooks",
 }
 
+// Time of the day fields, ex: "10:00". Other fields ending with "_time" are timestamps.
+var timeOfDayFields = datautils.NewSet("due_time", "next_activity_time") // nolint:gochecknoglobals
+
 func main() {
 	explorer, err := openapi.FileManager.GetExplorer()
 	if err != nil {
@@ -90,11 +95,20 @@ func main() {
 			schemas.Add("", object.ObjectName, object.DisplayName, field, object.URLPath, object.ResponseKey, nil)
 		}
 
+		schemas.AddFieldTypes("", object.ObjectName, timestampFieldTypes(object.FieldTypes))
+
 		for _, queryParam := range object.QueryParams {
 			registry.Add(queryParam, object.ObjectName)
 		}
 	}
 
+	writeObjects, err := explorer.WriteObjects(api3.NewDenyPathStrategy(ignoreEndpoints), nil)
+	if err != nil {
+		log.Fatalln(err)
+	}
+
+	scrapper.AddWriteOperations(schemas, "", writeObjects)
+
 	if err := metadata.FileManager.SaveSchemas(schemas); err != nil {
 		log.Fatalln(err)
 	}
@@ -105,3 +119,22 @@ func main() {
 
 	slog.Info("Completed.")
 }
+
+// timestampFieldTypes marks timestamps described as plain strings.
+// Pipedrive formats them as "2006-01-02 15:04:05" and dates as "2006-01-02" without stating it in OpenAPI file.
+func timestampFieldTypes(fieldTypes common.FieldTypes) common.FieldTypes {
+	for fieldName, valueType := range fieldTypes {
+		if valueType != common.ValueTypeString {
+			continue
+		}
+
+		switch {
+		case strings.HasSuffix(fieldName, "_time") && !timeOfDayFields.Has(fieldName):
+			fieldTypes[fieldName] = common.ValueTypeDateTime
+		case strings.HasSuffix(fieldName, "_date"):
+			fieldTypes[fieldName] = common.ValueTypeDate
+		}
+	}
+
+	return fieldTypes
+}
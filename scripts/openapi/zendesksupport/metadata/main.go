@@ -43,6 +43,10 @@ func main() {
 		}
 	}
 
+	// Help Center objects are nested under locale, therefore only ticketing objects have write operations.
+	scrapper.AddWriteOperations(schemas, zendesksupport.ModuleTicketing, support.WriteObjects())
+	scrapper.AddWriteOperations(schemas, zendesksupport.ModuleHelpCenter, nil)
+
 	goutils.MustBeNil(metadata.FileManager.SaveSchemas(schemas))
 	goutils.MustBeNil(metadata.FileManager.SaveQueryParamStats(scrapper.CalculateQueryParamStats(registry)))
 
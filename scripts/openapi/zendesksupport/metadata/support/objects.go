@@ -68,13 +68,7 @@ var (
 )
 
 func Objects() []api3.Schema {
-	explorer, err := openapi.SupportFileManager.GetExplorer(
-		api3.WithDisplayNamePostProcessors(
-			api3.CamelCaseToSpaceSeparated,
-			api3.CapitalizeFirstLetterEveryWord,
-		),
-	)
-	goutils.MustBeNil(err)
+	explorer := newExplorer()
 
 	objects, err := explorer.ReadObjectsGet(
 		api3.NewDenyPathStrategy(ignoreEndpoints),
@@ -85,3 +79,23 @@ func Objects() []api3.Schema {
 
 	return objects
 }
+
+// WriteObjects returns create, update and delete operations of ticketing objects.
+func WriteObjects() api3.WriteSchemas {
+	objects, err := newExplorer().WriteObjects(api3.NewDenyPathStrategy(ignoreEndpoints), nil)
+	goutils.MustBeNil(err)
+
+	return objects
+}
+
+func newExplorer() *api3.Explorer {
+	explorer, err := openapi.SupportFileManager.GetExplorer(
+		api3.WithDisplayNamePostProcessors(
+			api3.CamelCaseToSpaceSeparated,
+			api3.CapitalizeFirstLetterEveryWord,
+		),
+	)
+	goutils.MustBeNil(err)
+
+	return explorer
+}
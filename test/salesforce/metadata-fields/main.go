@@ -0,0 +1,57 @@
+package main
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/amp-labs/connectors/providers/salesforce"
+	connTest "github.com/amp-labs/connectors/test/salesforce"
+	"github.com/amp-labs/connectors/test/utils"
+)
+
+const fieldName = "Account.AmpTestComments__c"
+
+// Provisions custom field on Account, reads it back and then removes it.
+func main() {
+	// Handle Ctrl-C gracefully.
+	ctx, done := signal.NotifyContext(context.Background(), syscall.SIGINT, syscall.SIGTERM)
+	defer done()
+
+	// Set up slog logging.
+	utils.SetupLogging()
+
+	conn := connTest.GetSalesforceConnector(ctx)
+	defer utils.Close(conn)
+
+	created, err := conn.UpsertMetadataComponents(ctx, salesforce.CustomField{
+		FullName:     fieldName,
+		Label:        "Amp Test Comments",
+		Type:         "LongTextArea",
+		Length:       500,
+		VisibleLines: 10,
+		Description:  "Created by connectors test harness",
+	})
+	if err != nil {
+		utils.Fail("error creating custom field", "error", err)
+	}
+
+	utils.DumpJSON(created, os.Stdout)
+
+	fields, err := conn.ReadCustomFields(ctx, fieldName)
+	if err != nil {
+		utils.Fail("error reading custom field", "error", err)
+	}
+
+	utils.DumpJSON(fields, os.Stdout)
+
+	removed, err := conn.DeleteCustomFields(ctx, fieldName)
+	if err != nil {
+		utils.Fail("error deleting custom field", "error", err)
+	}
+
+	utils.DumpJSON(removed, os.Stdout)
+	fmt.Println("==> done")
+}
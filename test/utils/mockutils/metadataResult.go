@@ -13,6 +13,7 @@ var MetadataResultComparator = metadataResultComparator{}
 type metadataResultComparator struct{}
 
 // SubsetFields checks that expected ListObjectMetadataResult fields are a subset of actual metadata result.
+// Field types are compared only when expected.
 func (metadataResultComparator) SubsetFields(actual, expected *common.ListObjectMetadataResult) bool {
 	if len(expected.Result) == 0 {
 		invalidTest("please specify expected FieldsMap response")
@@ -38,6 +39,12 @@ func (metadataResultComparator) SubsetFields(actual, expected *common.ListObject
 				return false
 			}
 		}
+
+		for k, v := range expectedMetadata.FieldTypes {
+			if actualMetadata.FieldTypes[k] != v {
+				return false
+			}
+		}
 	}
 
 	return true
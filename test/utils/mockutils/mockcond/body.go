@@ -34,6 +34,22 @@ func Body(expected string) Check {
 	}
 }
 
+// BodyContains returns a check expecting body to include text fragment.
+// This is useful for payloads which are not JSON and have volatile parts.
+func BodyContains(fragment string) Check {
+	return func(w http.ResponseWriter, r *http.Request) bool {
+		body, err := io.ReadAll(r.Body)
+		if err != nil {
+			return false
+		}
+
+		_ = r.Body.Close()
+		r.Body = io.NopCloser(bytes.NewBuffer(body))
+
+		return strings.Contains(string(body), fragment)
+	}
+}
+
 func jsonBodyMatch(actual []byte, expected string) bool {
 	first := make(map[string]any)
 	if err := json.Unmarshal(actual, &first); err != nil {
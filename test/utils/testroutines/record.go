@@ -0,0 +1,23 @@
+package testroutines
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+type (
+	GetRecordsType = TestCase[common.GetRecordsParams, []common.ReadResultRow]
+	// GetRecords is a test suite useful for testing connectors.RecordConnector interface.
+	GetRecords GetRecordsType
+)
+
+// Run provides a procedure to test connectors.RecordConnector
+func (r GetRecords) Run(t *testing.T, builder ConnectorBuilder[connectors.RecordConnector]) {
+	t.Helper()
+	conn := builder.Build(t, r.Name)
+	output, err := conn.GetRecords(context.Background(), r.Input)
+	GetRecordsType(r).Validate(t, err, output)
+}
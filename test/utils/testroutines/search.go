@@ -0,0 +1,23 @@
+package testroutines
+
+import (
+	"context"
+	"testing"
+
+	"github.com/amp-labs/connectors"
+	"github.com/amp-labs/connectors/common"
+)
+
+type (
+	SearchType = TestCase[common.SearchParams, *common.ReadResult]
+	// Search is a test suite useful for testing connectors.SearchConnector interface.
+	Search SearchType
+)
+
+// Run provides a procedure to test connectors.SearchConnector
+func (s Search) Run(t *testing.T, builder ConnectorBuilder[connectors.SearchConnector]) {
+	t.Helper()
+	conn := builder.Build(t, s.Name)
+	output, err := conn.SearchRecords(context.Background(), s.Input)
+	SearchType(s).Validate(t, err, output)
+}
@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"bytes"
+	"go/format"
 	"log"
 	"os"
 	"path/filepath"
@@ -12,11 +14,14 @@ import (
 	"github.com/iancoleman/strcase"
 )
 
+const writePerm = 0o644
+
 var customFunctions = template.FuncMap{ // nolint:gochecknoglobals
 	"camel":     strcase.ToCamel,
 	"loweCamel": strcase.ToLowerCamel,
 	"snake":     strcase.ToSnake,
 	"upper":     strings.ToUpper,
+	"lower":     strings.ToLower,
 	"kebab":     strcase.ToKebab,
 	"singular": func(text string) string {
 		return naming.NewSingularString(text).String()
@@ -49,19 +54,27 @@ func writeOutputFile(tmpl *template.Template, data any, outputDirectoryName, tem
 
 	outputFileName, _ := strings.CutSuffix(templateFileName, ".tmpl")
 
-	file, err := os.Create(filepath.Join(outputDirectoryName, outputFileName))
-	if err != nil {
-		log.Fatalf("failed creating output file %v\n", err)
+	var buffer bytes.Buffer
+	if err := tmpl.Execute(&buffer, data); err != nil {
+		log.Fatalf("failed applying template %v\n", err)
 	}
 
-	err = tmpl.Execute(file, data)
-	if err != nil {
-		_ = file.Close()
+	output := buffer.Bytes()
 
-		log.Fatalf("failed applying template %v\n", err)
+	if strings.HasSuffix(outputFileName, ".go") {
+		// Templates with conditional blocks are easier to read when alignment is left to the formatter.
+		// Output which doesn't compile yet is saved as is.
+		if formatted, err := format.Source(output); err == nil {
+			output = formatted
+		} else {
+			log.Printf("failed formatting %v: %v\n", outputFileName, err)
+		}
 	}
 
-	_ = file.Close()
+	err := os.WriteFile(filepath.Join(outputDirectoryName, outputFileName), output, writePerm)
+	if err != nil {
+		log.Fatalf("failed creating output file %v\n", err)
+	}
 }
 
 func getTemplateNames(dirName string) []string {
@@ -0,0 +1,460 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/datautils"
+	"github.com/amp-labs/connectors/internal/staticschema"
+	"github.com/amp-labs/connectors/providers"
+	"github.com/amp-labs/connectors/tools/fileconv/api3"
+	"github.com/amp-labs/connectors/tools/scrapper"
+	"github.com/spf13/cobra"
+)
+
+var (
+	ErrProviderNotInCatalog = errors.New("provider is not in the catalog")
+	ErrInvalidConfig        = errors.New("invalid OpenAPI config")
+	ErrNoObjects            = errors.New("no objects were found in the OpenAPI file")
+)
+
+var fromOpenAPICmd = &cobra.Command{ //nolint:gochecknoglobals
+	Use:   "from-openapi openapiFile configFile",
+	Short: "Create a complete connector from OpenAPI file",
+	Long: "Explores OpenAPI file to produce a connector package with Read, Write, Delete and ListObjectMetadata, " +
+		"its static schemas file, unit tests for every object and manual tests. " +
+		"Config file describes pagination and location of records in the response.",
+	Args: cobra.ExactArgs(2), // nolint:gomnd
+	Run: func(cmd *cobra.Command, args []string) {
+		recipe := GetRecipe()
+
+		spec, err := os.ReadFile(args[0])
+		if err != nil {
+			log.Fatalf("failed reading OpenAPI file %v\n", err)
+		}
+
+		config, err := loadOpenAPIConfig(args[1])
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		packageDir := filepath.Join(recipe.Output, recipe.Package)
+		metadataDir := filepath.Join(packageDir, "metadata")
+
+		data, schemas, err := newOpenAPIRecipe(recipe, spec, config)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err = os.MkdirAll(metadataDir, os.ModePerm); err != nil {
+			log.Fatalf("failed creating output directory %v\n", err)
+		}
+
+		err = staticschema.NewFileManager(nil, directoryLocator(metadataDir)).SaveSchemas(schemas)
+		if err != nil {
+			log.Fatalf("failed saving schemas %v\n", err)
+		}
+
+		// URL paths are known once the common prefix is moved to the module path.
+		data.resolveURLPaths(schemas)
+
+		applyTemplatesFromDirectory("openapi/connector", data, packageDir)
+		applyTemplatesFromDirectory("openapi/metadata", data, metadataDir)
+
+		testDir := filepath.Join(recipe.Output, "test", recipe.Package)
+		applyTemplatesFromDirectory("openapi/test", data, testDir)
+
+		for _, directory := range []string{"read", "write-delete", "metadata"} {
+			applyTemplatesFromDirectory(filepath.Join("openapi/test", directory), data,
+				filepath.Join(testDir, directory),
+			)
+		}
+
+		completed(recipe)
+	},
+}
+
+// OpenAPIConfig describes provider conventions which cannot be inferred from OpenAPI file.
+type OpenAPIConfig struct {
+	// IgnoreEndpoints are URL paths which are not objects. Star symbol is supported, ex: "*/search".
+	IgnoreEndpoints []string `json:"ignoreEndpoints,omitempty"`
+	// ObjectEndpoints maps URL path to object name, when it differs from the last URL segment.
+	ObjectEndpoints map[string]string `json:"objectEndpoints,omitempty"`
+	// DisplayNames overrides display name of objects.
+	DisplayNames map[string]string `json:"displayNames,omitempty"`
+	// ResponseKey is a field of read response holding the list of records, ex: "data".
+	// Empty value means the field matches object name.
+	ResponseKey string `json:"responseKey,omitempty"`
+	// ResponseKeyOverrides lists objects which deviate from ResponseKey.
+	ResponseKeyOverrides map[string]string `json:"responseKeyOverrides,omitempty"`
+	// RecordKey is a dot separated location of the record in write response.
+	// Empty value means the response is the record itself.
+	RecordKey string `json:"recordKey,omitempty"`
+	// IDField is the name of record identifier. Defaults to "id".
+	IDField string `json:"idField,omitempty"`
+	// Pagination applies to every object.
+	Pagination staticschema.Pagination `json:"pagination"`
+}
+
+func loadOpenAPIConfig(filename string) (*OpenAPIConfig, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	var config OpenAPIConfig
+	if err = json.Unmarshal(data, &config); err != nil {
+		return nil, errors.Join(ErrInvalidConfig, err)
+	}
+
+	if len(config.IDField) == 0 {
+		config.IDField = "id"
+	}
+
+	if len(config.Pagination.Style) == 0 {
+		config.Pagination.Style = common.PaginationNone
+	}
+
+	if err = validatePagination(config.Pagination); err != nil {
+		return nil, err
+	}
+
+	return &config, nil
+}
+
+func validatePagination(pagination staticschema.Pagination) error {
+	if len(pagination.PageSizeParam) != 0 && pagination.PageSize == 0 {
+		return fmt.Errorf("%w: pageSizeParam requires pageSize", ErrInvalidConfig)
+	}
+
+	switch pagination.Style {
+	case common.PaginationNone:
+		return nil
+	case common.PaginationNextURL:
+		if len(pagination.ResponsePath) == 0 {
+			return fmt.Errorf("%w: %v pagination requires responsePath", ErrInvalidConfig, pagination.Style)
+		}
+	case common.PaginationCursor:
+		if len(pagination.ResponsePath) == 0 || len(pagination.RequestParam) == 0 {
+			return fmt.Errorf("%w: %v pagination requires responsePath and requestParam",
+				ErrInvalidConfig, pagination.Style)
+		}
+	case common.PaginationOffset, common.PaginationPage:
+		// Page is considered to be the last once it has fewer records than requested.
+		if len(pagination.RequestParam) == 0 || len(pagination.PageSizeParam) == 0 || pagination.PageSize == 0 {
+			return fmt.Errorf("%w: %v pagination requires requestParam, pageSizeParam and pageSize",
+				ErrInvalidConfig, pagination.Style)
+		}
+	default:
+		return fmt.Errorf("%w: unknown pagination style %v", ErrInvalidConfig, pagination.Style)
+	}
+
+	return nil
+}
+
+var paginationConstants = map[common.PaginationStyle]string{ //nolint:gochecknoglobals
+	common.PaginationNone:    "PaginationNone",
+	common.PaginationCursor:  "PaginationCursor",
+	common.PaginationOffset:  "PaginationOffset",
+	common.PaginationPage:    "PaginationPage",
+	common.PaginationNextURL: "PaginationNextURL",
+}
+
+// openAPIRecipe is the data available to templates under "openapi" directory.
+type openAPIRecipe struct {
+	*Recipe
+	// CatalogName is the value of providers.Provider.
+	CatalogName string
+	Auth        authRecipe
+	// Workspace is true when the catalog base URL requires workspace substitution.
+	Workspace  bool
+	Pagination paginationRecipe
+	Record     jsonPathRecipe
+	IDField    string
+
+	ReadObjects   []objectRecipe
+	CreateObjects []objectRecipe
+	UpdateObjects []objectRecipe
+	DeleteObjects []objectRecipe
+
+	// ReadObject and WriteObject are used by manual tests.
+	ReadObject  string
+	WriteObject string
+}
+
+type authRecipe struct {
+	Type string
+	// APIKeyIn is either "header" or "query".
+	APIKeyIn string
+	AuthURL  string
+	TokenURL string
+}
+
+type paginationRecipe struct {
+	Style string
+	// Constant is the name of common.PaginationStyle constant.
+	Constant      string
+	RequestParam  string
+	PageSizeParam string
+	PageSize      int
+	Response      jsonPathRecipe
+}
+
+// jsonPathRecipe is a dot separated path split into jsonquery zoom and the target key.
+type jsonPathRecipe struct {
+	Zoom []string
+	Key  string
+}
+
+type objectRecipe struct {
+	Name        string
+	DisplayName string
+	Fields      []string
+	URLPath     string
+	Method      string
+	// Response is a sample payload returned by the mock server in unit tests.
+	Response string
+}
+
+func newOpenAPIRecipe(
+	recipe *Recipe, spec []byte, config *OpenAPIConfig,
+) (*openAPIRecipe, *staticschema.Metadata, error) {
+	catalogName, info, err := findCatalogEntry(recipe.Provider)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	explorer, err := api3.NewOpenapiFileManager(spec).GetExplorer(
+		api3.WithDisplayNamePostProcessors(
+			api3.CamelCaseToSpaceSeparated,
+			api3.CapitalizeFirstLetterEveryWord,
+		),
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	schemas, err := exploreObjects(explorer, config)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	data := &openAPIRecipe{
+		Recipe:      recipe,
+		CatalogName: catalogName,
+		Auth:        newAuthRecipe(info),
+		Workspace:   strings.Contains(info.BaseURL, "{{.workspace}}"),
+		Pagination: paginationRecipe{
+			Style:         string(config.Pagination.Style),
+			Constant:      paginationConstants[config.Pagination.Style],
+			RequestParam:  config.Pagination.RequestParam,
+			PageSizeParam: config.Pagination.PageSizeParam,
+			PageSize:      config.Pagination.PageSize,
+			Response:      newJSONPathRecipe(config.Pagination.ResponsePath),
+		},
+		Record:  newJSONPathRecipe(config.RecordKey),
+		IDField: config.IDField,
+	}
+
+	module := schemas.Modules[staticschema.RootModuleID]
+	sampleRecord := fmt.Sprintf(`{"%v":"1"}`, config.IDField)
+	writeResponse := newJSONPathRecipe(config.RecordKey).wrap(sampleRecord)
+
+	for _, name := range sortedKeys(module.Objects) {
+		object := module.Objects[name]
+		data.ReadObjects = append(data.ReadObjects, objectRecipe{
+			Name:        name,
+			DisplayName: object.DisplayName,
+			Fields:      sortedKeys(object.FieldsMap),
+			Response:    newJSONPathRecipe(object.ResponseKey).wrap("[" + sampleRecord + "]"),
+		})
+
+		for operation, list := range map[common.Operation]*[]objectRecipe{
+			common.OperationCreate: &data.CreateObjects,
+			common.OperationUpdate: &data.UpdateObjects,
+			common.OperationDelete: &data.DeleteObjects,
+		} {
+			if details, ok := object.Operations.Lookup(operation); ok {
+				*list = append(*list, objectRecipe{
+					Name:     name,
+					Method:   details.Method,
+					Response: writeResponse,
+				})
+			}
+		}
+	}
+
+	data.ReadObject = data.ReadObjects[0].Name
+	data.WriteObject = data.findWriteObject()
+
+	return data, schemas, nil
+}
+
+func exploreObjects(explorer *api3.Explorer, config *OpenAPIConfig) (*staticschema.Metadata, error) {
+	responseKeys := datautils.NewDefaultMap(config.ResponseKeyOverrides, func(objectName string) string {
+		if len(config.ResponseKey) == 0 {
+			return objectName
+		}
+
+		return config.ResponseKey
+	})
+
+	readObjects, err := explorer.ReadObjectsGet(
+		api3.NewDenyPathStrategy(config.IgnoreEndpoints),
+		config.ObjectEndpoints, config.DisplayNames,
+		api3.CustomMappingObjectCheck(responseKeys),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	schemas := staticschema.NewMetadata()
+
+	for _, object := range readObjects {
+		if object.Problem != nil {
+			log.Printf("schema not extracted for %v: %v\n", object.ObjectName, object.Problem)
+
+			continue
+		}
+
+		for _, field := range object.Fields {
+			schemas.Add("", object.ObjectName, object.DisplayName, field, object.URLPath, object.ResponseKey, nil)
+		}
+	}
+
+	if len(schemas.Modules) == 0 {
+		return nil, ErrNoObjects
+	}
+
+	writeObjects, err := explorer.WriteObjects(api3.NewDenyPathStrategy(config.IgnoreEndpoints), config.ObjectEndpoints)
+	if err != nil {
+		return nil, err
+	}
+
+	scrapper.AddWriteOperations(schemas, "", writeObjects)
+
+	return schemas, nil
+}
+
+// resolveURLPaths sets full URL path of every object, as it will be requested by the connector.
+func (r *openAPIRecipe) resolveURLPaths(schemas *staticschema.Metadata) {
+	for _, objects := range [][]objectRecipe{r.ReadObjects, r.CreateObjects, r.UpdateObjects, r.DeleteObjects} {
+		for index, object := range objects {
+			objects[index].URLPath, _ = schemas.LookupURLPath("", object.Name)
+		}
+	}
+}
+
+// findWriteObject returns object which can be created, updated and removed.
+func (r *openAPIRecipe) findWriteObject() string {
+	updates := datautils.NewStringSet()
+	for _, object := range r.UpdateObjects {
+		updates.AddOne(object.Name)
+	}
+
+	deletes := datautils.NewStringSet()
+	for _, object := range r.DeleteObjects {
+		deletes.AddOne(object.Name)
+	}
+
+	for _, object := range r.CreateObjects {
+		if updates.Has(object.Name) && deletes.Has(object.Name) {
+			return object.Name
+		}
+	}
+
+	if len(r.CreateObjects) != 0 {
+		return r.CreateObjects[0].Name
+	}
+
+	return r.ReadObject
+}
+
+func findCatalogEntry(provider string) (string, *providers.ProviderInfo, error) {
+	catalog, err := providers.ReadCatalog()
+	if err != nil {
+		return "", nil, err
+	}
+
+	for name, info := range catalog.Catalog {
+		if strings.EqualFold(name, provider) {
+			return name, &info, nil
+		}
+	}
+
+	return "", nil, fmt.Errorf("%w: %v", ErrProviderNotInCatalog, provider)
+}
+
+func newAuthRecipe(info *providers.ProviderInfo) authRecipe {
+	auth := authRecipe{
+		Type: string(info.AuthType),
+	}
+
+	if info.ApiKeyOpts != nil {
+		auth.APIKeyIn = string(info.ApiKeyOpts.AttachmentType)
+	}
+
+	if info.Oauth2Opts != nil {
+		auth.AuthURL = info.Oauth2Opts.AuthURL
+		auth.TokenURL = info.Oauth2Opts.TokenURL
+	}
+
+	return auth
+}
+
+func newJSONPathRecipe(path string) jsonPathRecipe {
+	if len(path) == 0 {
+		return jsonPathRecipe{}
+	}
+
+	parts := strings.Split(path, ".")
+
+	return jsonPathRecipe{
+		Zoom: parts[:len(parts)-1],
+		Key:  parts[len(parts)-1],
+	}
+}
+
+// wrap nests JSON value under the path.
+func (p jsonPathRecipe) wrap(value string) string {
+	if len(p.Key) == 0 {
+		return value
+	}
+
+	result := fmt.Sprintf(`{"%v":%v}`, p.Key, value)
+
+	for index := len(p.Zoom) - 1; index >= 0; index-- {
+		result = fmt.Sprintf(`{"%v":%v}`, p.Zoom[index], result)
+	}
+
+	return result
+}
+
+func sortedKeys[V any](dict map[string]V) []string {
+	keys := make([]string, 0, len(dict))
+	for key := range dict {
+		keys = append(keys, key)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+// directoryLocator resolves files within a directory chosen by the user.
+type directoryLocator string
+
+func (l directoryLocator) AbsPathTo(filename string) string {
+	return filepath.Join(string(l), filename)
+}
+
+func init() {
+	rootCmd.AddCommand(fromOpenAPICmd)
+}
@@ -4,9 +4,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
+	"maps"
 	"net/http"
+	"sort"
 	"strings"
 
+	"github.com/amp-labs/connectors/common"
 	"github.com/amp-labs/connectors/internal/datautils"
 	"github.com/getkin/kin-openapi/openapi3"
 )
@@ -37,6 +40,8 @@ type Schema struct {
 	ObjectName  string
 	DisplayName string
 	Fields      []string
+	// FieldTypes are value types of fields derived from the property definitions.
+	FieldTypes  common.FieldTypes
 	QueryParams []string
 	URLPath     string
 	ResponseKey string
@@ -58,6 +63,30 @@ func (s Schemas) Combine(others Schemas) Schemas {
 	return registry.Values()
 }
 
+// WriteSchema lists operations which modify records of the object.
+// Nil operation means it is not supported.
+type WriteSchema struct {
+	ObjectName string
+	URLPath    string
+	Create     *WriteOperation
+	Update     *WriteOperation
+	Delete     *WriteOperation
+}
+
+// WriteOperation describes endpoint that creates, updates or removes a record.
+type WriteOperation struct {
+	// Method is HTTP method. Ex: POST, PATCH, PUT, DELETE.
+	Method string
+	// URLPath is a path template. Ex: /contacts/{contact_id}.
+	URLPath string
+	// RecordIDParam is the name of path parameter holding record identifier. Empty for create.
+	RecordIDParam string
+	// Fields are properties of the request body.
+	Fields []string
+}
+
+type WriteSchemas []WriteSchema
+
 func (s Schema) String() string {
 	if s.Problem != nil {
 		return fmt.Sprintf("    {%v}    ", s.ObjectName)
@@ -101,12 +130,13 @@ func (p PathItem) RetrieveSchemaOperation(
 		displayName = displayProcessor(displayName)
 	}
 
-	fields, responseKey, err := extractObjectFields(p.objectName, schema, locator, propertyFlattener)
+	fieldTypes, responseKey, err := extractObjectFields(p.objectName, schema, locator, propertyFlattener)
 
 	return &Schema{
 		ObjectName:  p.objectName,
 		DisplayName: displayName,
-		Fields:      fields,
+		Fields:      fieldNames(fieldTypes),
+		FieldTypes:  fieldTypes,
 		QueryParams: getQueryParameters(operation),
 		URLPath:     p.urlPath,
 		ResponseKey: responseKey,
@@ -114,6 +144,76 @@ func (p PathItem) RetrieveSchemaOperation(
 	}, true, nil
 }
 
+// RetrieveWriteOperations collects create operation from the collection path item
+// and update, delete operations from the record path item. Record path item is optional.
+func (p PathItem) RetrieveWriteOperations(record *PathItem, mime string) (*WriteSchema, bool, error) {
+	schema := &WriteSchema{
+		ObjectName: p.objectName,
+		URLPath:    p.urlPath,
+	}
+
+	var err error
+
+	schema.Create, err = newWriteOperation(p.objectName, http.MethodPost, p.urlPath, "", p.delegate.Post, mime)
+	if err != nil {
+		return nil, false, err
+	}
+
+	if record != nil {
+		parameter := strings.Trim(strings.TrimPrefix(record.urlPath, p.urlPath+"/"), "{}")
+
+		update, method := record.delegate.Patch, http.MethodPatch
+		if update == nil {
+			update, method = record.delegate.Put, http.MethodPut
+		}
+
+		schema.Update, err = newWriteOperation(p.objectName, method, record.urlPath, parameter, update, mime)
+		if err != nil {
+			return nil, false, err
+		}
+
+		schema.Delete, err = newWriteOperation(p.objectName, http.MethodDelete,
+			record.urlPath, parameter, record.delegate.Delete, mime)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+
+	found := schema.Create != nil || schema.Update != nil || schema.Delete != nil
+
+	return schema, found, nil
+}
+
+func newWriteOperation(
+	objectName, method, urlPath, recordIDParam string,
+	operation *openapi3.Operation, mime string,
+) (*WriteOperation, error) {
+	if operation == nil {
+		return nil, nil // nolint:nilnil
+	}
+
+	fields := make([]string, 0)
+
+	if body := extractRequestBodySchema(operation, mime); body != nil {
+		// Request body fields are taken as is, flattening applies to response schemas only.
+		fieldTypes, err := extractFields(objectName, func(objectName, fieldName string) bool {
+			return false
+		}, body)
+		if err != nil {
+			return nil, err
+		}
+
+		fields = fieldNames(fieldTypes)
+	}
+
+	return &WriteOperation{
+		Method:        method,
+		URLPath:       urlPath,
+		RecordIDParam: recordIDParam,
+		Fields:        fields,
+	}, nil
+}
+
 func (p PathItem) selectOperation(operationName string) *openapi3.Operation {
 	switch operationName {
 	case "POST":
@@ -130,7 +230,7 @@ func (p PathItem) selectOperation(operationName string) *openapi3.Operation {
 func extractObjectFields(
 	objectName string, schema *openapi3.Schema, locator ObjectArrayLocator,
 	propertyFlattener PropertyFlattener,
-) (fields []string, location string, err error) {
+) (fields common.FieldTypes, location string, err error) {
 	switch getSchemaType(schema) {
 	case schemaTypeObject:
 		return extractFieldsFromArrayHolder(objectName, schema, locator, propertyFlattener)
@@ -153,7 +253,7 @@ func extractObjectFields(
 func extractFieldsFromArrayHolder(
 	objectName string, schema *openapi3.Schema, locator ObjectArrayLocator,
 	propertyFlattener PropertyFlattener,
-) (fields []string, location string, err error) {
+) (fields common.FieldTypes, location string, err error) {
 	definitions := []openapi3.Schemas{
 		schema.Properties,
 	}
@@ -184,7 +284,7 @@ func extractFieldsFromArrayHolder(
 	if isBooleanTruthful(schema.AdditionalProperties.Has) {
 		// this schema is dynamic.
 		// the fields cannot be known.
-		return []string{}, "", nil
+		return common.FieldTypes{}, "", nil
 	}
 
 	return nil, "", createUnprocessableObjectError(objectName)
@@ -194,7 +294,7 @@ func extractFieldsFromArrayHolder(
 func extractFieldsFromArray(
 	objectName string, schema *openapi3.Schema,
 	propertyFlattener PropertyFlattener,
-) (fields []string, location string, err error) {
+) (fields common.FieldTypes, location string, err error) {
 	items, ok := getItems(schema.NewRef())
 	if !ok {
 		return nil, "", createUnprocessableObjectError(objectName)
@@ -268,11 +368,24 @@ func extractSchema(operation *openapi3.Operation, mime string) *openapi3.Schema
 	return schemaValue
 }
 
+func extractRequestBodySchema(operation *openapi3.Operation, mime string) *openapi3.Schema {
+	if operation.RequestBody == nil || operation.RequestBody.Value == nil {
+		return nil
+	}
+
+	mediaType := operation.RequestBody.Value.Content.Get(mime)
+	if mediaType == nil || mediaType.Schema == nil {
+		return nil
+	}
+
+	return mediaType.Schema.Value
+}
+
 func extractFields(
 	objectName string,
 	propertyFlattener PropertyFlattener, source *openapi3.Schema,
-) ([]string, error) {
-	combined := make(datautils.Set[string])
+) (common.FieldTypes, error) {
+	combined := make(common.FieldTypes)
 
 	if source.AnyOf != nil {
 		// this object can be represented by various definitions
@@ -284,7 +397,7 @@ func extractFields(
 				return nil, err
 			}
 
-			combined.Add(fields)
+			maps.Copy(combined, fields)
 		}
 	}
 
@@ -297,7 +410,7 @@ func extractFields(
 				return nil, err
 			}
 
-			combined.Add(fields)
+			maps.Copy(combined, fields)
 		}
 	}
 
@@ -310,14 +423,73 @@ func extractFields(
 				return nil, err
 			}
 
-			combined.Add(fields)
+			maps.Copy(combined, fields)
 		} else {
 			// This is just a normal usual case where top level fields are collected as is.
-			combined.AddOne(property)
+			combined[property] = getValueType(propertySchema.Value)
+		}
+	}
+
+	return combined, nil
+}
+
+// fieldNames returns sorted names of the fields.
+func fieldNames(fieldTypes common.FieldTypes) []string {
+	fields := datautils.Map[string, common.ValueType](fieldTypes).Keys()
+	sort.Strings(fields)
+
+	return fields
+}
+
+// getValueType translates property definition into the value type.
+// Objects holding an amount and a currency are money, their amount is a number.
+func getValueType(schema *openapi3.Schema) common.ValueType {
+	if schema == nil || schema.Type == nil {
+		return common.ValueTypeOther
+	}
+
+	switch {
+	case schema.Type.Is(openapi3.TypeString):
+		switch schema.Format {
+		case "date-time":
+			return common.ValueTypeDateTime
+		case "date":
+			return common.ValueTypeDate
+		default:
+			return common.ValueTypeString
+		}
+	case schema.Type.Is(openapi3.TypeBoolean):
+		return common.ValueTypeBoolean
+	case schema.Type.Is(openapi3.TypeInteger):
+		return common.ValueTypeInt
+	case schema.Type.Is(openapi3.TypeNumber):
+		return common.ValueTypeFloat
+	case schema.Type.Is(openapi3.TypeObject) && isMoney(schema):
+		return common.ValueTypeFloat
+	default:
+		return common.ValueTypeOther
+	}
+}
+
+// isMoney checks if object has a currency next to the amount, ex: {"amount": 200, "currency": "EUR"}.
+func isMoney(schema *openapi3.Schema) bool {
+	if _, ok := schema.Properties["currency"]; !ok {
+		return false
+	}
+
+	for _, key := range []string{"amount", "value"} {
+		amount, ok := schema.Properties[key]
+		if !ok {
+			continue
+		}
+
+		if valueType := getValueType(amount.Value); valueType == common.ValueTypeFloat ||
+			valueType == common.ValueTypeInt {
+			return true
 		}
 	}
 
-	return combined.List(), nil
+	return false
 }
 
 type definitionSchemaType int
@@ -100,6 +100,41 @@ func (e Explorer) ReadObjects(
 	return schemas, nil
 }
 
+// WriteObjects will explore OpenAPI file returning create, update and delete operations of every object.
+// Objects are discovered the same way as in ReadObjects, by the collection URL path. Ex: /contacts.
+//
+// Create is a POST operation on the collection path.
+// Update is a PATCH operation, or PUT if the former is missing, on the record path. Ex: /contacts/{contact_id}.
+// Delete is a DELETE operation on the record path.
+//
+// Objects without any write operation are omitted.
+func (e Explorer) WriteObjects(
+	pathMatcher PathMatcher,
+	objectEndpoints map[string]string,
+) (WriteSchemas, error) {
+	schemas := make(WriteSchemas, 0)
+	paths := e.schema.GetPaths()
+
+	for _, path := range e.GetPathItems(pathMatcher, objectEndpoints) {
+		schema, found, err := path.RetrieveWriteOperations(
+			findRecordPathItem(paths, path.urlPath), e.mediaType,
+		)
+		if err != nil {
+			return nil, err
+		}
+
+		if found {
+			schemas = append(schemas, *schema)
+		}
+	}
+
+	sort.Slice(schemas, func(i, j int) bool {
+		return schemas[i].ObjectName < schemas[j].ObjectName
+	})
+
+	return schemas, nil
+}
+
 // GetPathItems returns path items where object name is a single word.
 func (e Explorer) GetPathItems(
 	pathMatcher PathMatcher, endpointResources map[string]string,
@@ -144,3 +179,33 @@ func (e Explorer) GetPathItems(
 
 	return items.Values()
 }
+
+// findRecordPathItem returns path item which operates on a single record of the collection.
+// Record path is the collection path followed by one path parameter. Ex: /contacts/{contact_id}.
+func findRecordPathItem(paths map[string]*openapi3.PathItem, collectionPath string) *PathItem {
+	candidates := make([]string, 0)
+
+	for path := range paths {
+		parameter, ok := strings.CutPrefix(path, collectionPath+"/")
+		if !ok {
+			continue
+		}
+
+		if strings.HasPrefix(parameter, "{") && strings.HasSuffix(parameter, "}") &&
+			!strings.Contains(parameter, "/") {
+			candidates = append(candidates, path)
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil
+	}
+
+	// Multiple parameter names for the same path is unusual, choose one deterministically.
+	sort.Strings(candidates)
+
+	return &PathItem{
+		urlPath:  candidates[0],
+		delegate: paths[candidates[0]],
+	}
+}
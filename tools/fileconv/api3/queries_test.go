@@ -0,0 +1,91 @@
+package api3
+
+import (
+	"testing"
+
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/test/utils/testutils"
+)
+
+func TestWriteObjects(t *testing.T) {
+	t.Parallel()
+
+	explorer, err := NewOpenapiFileManager(
+		testutils.DataFromFile(t, "write-operations.yaml"),
+	).GetExplorer()
+	if err != nil {
+		t.Fatalf("failed to load OpenAPI file: %v", err)
+	}
+
+	output, err := explorer.WriteObjects(
+		NewDenyPathStrategy([]string{"/admin/*"}),
+		map[string]string{"/tags": "labels"},
+	)
+
+	expected := WriteSchemas{{
+		ObjectName: "contacts",
+		URLPath:    "/contacts",
+		Create: &WriteOperation{
+			Method:  "POST",
+			URLPath: "/contacts",
+			Fields:  []string{"email", "name"},
+		},
+		Update: &WriteOperation{
+			Method:        "PATCH",
+			URLPath:       "/contacts/{contact_id}",
+			RecordIDParam: "contact_id",
+			Fields:        []string{"email", "name"},
+		},
+		Delete: &WriteOperation{
+			Method:        "DELETE",
+			URLPath:       "/contacts/{contact_id}",
+			RecordIDParam: "contact_id",
+			Fields:        []string{},
+		},
+	}, {
+		ObjectName: "labels",
+		URLPath:    "/tags",
+		Create: &WriteOperation{
+			Method:  "POST",
+			URLPath: "/tags",
+			Fields:  []string{"label"},
+		},
+		Update: &WriteOperation{
+			Method:        "PUT",
+			URLPath:       "/tags/{id}",
+			RecordIDParam: "id",
+			Fields:        []string{},
+		},
+	}}
+
+	testutils.CheckOutputWithError(t, "WriteObjects", expected, nil, output, err)
+}
+
+func TestReadObjectsFieldTypes(t *testing.T) {
+	t.Parallel()
+
+	explorer, err := NewOpenapiFileManager(
+		testutils.DataFromFile(t, "field-types.yaml"),
+	).GetExplorer()
+	if err != nil {
+		t.Fatalf("failed to load OpenAPI file: %v", err)
+	}
+
+	output, err := explorer.ReadObjectsGet(NewDenyPathStrategy(nil), nil, nil, DataObjectLocator)
+	if err != nil || len(output) != 1 {
+		t.Fatalf("failed to read objects: %v", err)
+	}
+
+	expected := common.FieldTypes{
+		"add_time":            common.ValueTypeDateTime,
+		"archived":            common.ValueTypeBoolean,
+		"expected_close_date": common.ValueTypeDate,
+		"id":                  common.ValueTypeInt,
+		"labels":              common.ValueTypeOther,
+		"probability":         common.ValueTypeFloat,
+		"title":               common.ValueTypeString,
+		"value":               common.ValueTypeFloat,
+	}
+
+	testutils.CheckOutputWithError(t, "ReadObjectsGet", expected, nil, output[0].FieldTypes, nil)
+}
@@ -0,0 +1,34 @@
+package scrapper
+
+import (
+	"github.com/amp-labs/connectors/common"
+	"github.com/amp-labs/connectors/internal/staticschema"
+	"github.com/amp-labs/connectors/tools/fileconv/api3"
+)
+
+// AddWriteOperations saves create, update and delete operations discovered by the OpenAPI explorer.
+// Only objects that were previously added to the schemas are enriched.
+// Objects of the module without discovered operations are saved as read only.
+func AddWriteOperations(schemas *staticschema.Metadata, moduleID common.ModuleID, objects api3.WriteSchemas) {
+	defer schemas.SetReadOnly(moduleID)
+
+	for _, object := range objects {
+		operations := map[common.Operation]*api3.WriteOperation{
+			common.OperationCreate: object.Create,
+			common.OperationUpdate: object.Update,
+			common.OperationDelete: object.Delete,
+		}
+
+		for operation, details := range operations {
+			if details == nil {
+				continue
+			}
+
+			schemas.AddOperation(moduleID, object.ObjectName, operation, staticschema.Operation{
+				Method:        details.Method,
+				RecordIDParam: details.RecordIDParam,
+				Fields:        details.Fields,
+			})
+		}
+	}
+}